@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ans-group/sdk-go/pkg/connection"
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// accountClient is the provider data passed to resources. The SDK does not
+// accept a context, so each operation obtains a service bound to its own
+// context, allowing timeouts to abort in-flight HTTP requests.
+type accountClient struct {
+	conn *connection.APIConnection
+
+	// presets holds the custom role presets declared in the provider block.
+	presets map[string][]accountservice.ApplicationServiceScope
+
+	// errorOnPrivilegeEscalation reports unacknowledged privilege escalations
+	// as errors rather than warnings.
+	errorOnPrivilegeEscalation bool
+
+	applicationLocksMu sync.Mutex
+	applicationLocks   map[string]*sync.Mutex
+}
+
+func newAccountClient(conn *connection.APIConnection) *accountClient {
+	return &accountClient{
+		conn:             conn,
+		applicationLocks: make(map[string]*sync.Mutex),
+	}
+}
+
+// lockApplication serialises changes to an application's service scopes
+// within this provider instance. Scopes are written as a whole, so resources
+// that read, modify and write them must hold the lock throughout. The
+// returned function releases the lock.
+func (c *accountClient) lockApplication(appID string) func() {
+	c.applicationLocksMu.Lock()
+	lock, ok := c.applicationLocks[appID]
+	if !ok {
+		lock = &sync.Mutex{}
+		c.applicationLocks[appID] = lock
+	}
+	c.applicationLocksMu.Unlock()
+
+	lock.Lock()
+
+	return lock.Unlock
+}
+
+// UpdateApplicationScopes reads an application's service scopes, applies
+// update and writes the result back, holding the application's lock
+// throughout. The scopes are deleted when update returns none.
+func (c *accountClient) UpdateApplicationScopes(ctx context.Context, appID string, update func([]accountservice.ApplicationServiceScope) ([]accountservice.ApplicationServiceScope, error)) error {
+	unlock := c.lockApplication(appID)
+	defer unlock()
+
+	service := c.Service(ctx)
+
+	current, err := service.GetApplicationServices(appID)
+	if err != nil {
+		return err
+	}
+
+	scopes, err := update(current.Scopes)
+	if err != nil {
+		return err
+	}
+
+	if len(scopes) == 0 {
+		return service.DeleteApplicationServices(appID)
+	}
+
+	return service.SetApplicationServices(appID, accountservice.SetServiceRequest{
+		Scopes: normalizeApplicationScope(scopes),
+	})
+}
+
+// Service returns an AccountService whose requests are bound to ctx.
+func (c *accountClient) Service(ctx context.Context) accountservice.AccountService {
+	return accountservice.NewService(&contextConnection{
+		APIConnection: c.conn,
+		ctx:           ctx,
+	})
+}
+
+// updateApplicationRequest mirrors accountservice.UpdateApplicationRequest
+// without omitempty, so that a description can be cleared.
+type updateApplicationRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// UpdateApplication updates an application's name and description. Unlike the
+// SDK's UpdateApplication, an empty description is sent rather than omitted.
+func (c *accountClient) UpdateApplication(ctx context.Context, appID string, req accountservice.UpdateApplicationRequest) error {
+	body := updateApplicationRequest{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	_, err := connection.Patch[accountservice.Application](
+		&contextConnection{APIConnection: c.conn, ctx: ctx},
+		fmt.Sprintf("/account/v1/applications/%s", appID),
+		&body,
+		connection.NotFoundResponseHandler(&accountservice.ApplicationNotFoundError{ID: appID}),
+	)
+
+	return err
+}
+
+// createRecoveryTimeout bounds the requests which recover a create once its
+// own context has expired.
+const createRecoveryTimeout = 30 * time.Second
+
+// pendingCreate identifies the application made by a create which got no
+// definitive response. The IDs of the applications already named Name are
+// recorded in Existing before the create, so that any other application with
+// the name was made by it.
+type pendingCreate struct {
+	Name     string   `json:"name"`
+	Existing []string `json:"existing"`
+}
+
+// newPendingCreate records the applications named name before another is
+// created.
+func newPendingCreate(service accountservice.AccountService, name string) (pendingCreate, error) {
+	applications, err := findApplicationsByName(service, name)
+	if err != nil {
+		return pendingCreate{}, err
+	}
+
+	pending := pendingCreate{Name: name, Existing: make([]string, len(applications))}
+	for i, application := range applications {
+		pending.Existing[i] = application.ID
+	}
+
+	return pending, nil
+}
+
+// find returns the application made by the create, with its key where the API
+// returns one. The application is empty when none was made, and an error is
+// returned when several applications have been given the name since, as the
+// one made by the create cannot be told apart.
+func (p pendingCreate) find(service accountservice.AccountService) (accountservice.Application, error) {
+	applications, err := findApplicationsByName(service, p.Name)
+	if err != nil {
+		return accountservice.Application{}, err
+	}
+
+	var created []accountservice.Application
+	for _, application := range applications {
+		if !slices.Contains(p.Existing, application.ID) {
+			created = append(created, application)
+		}
+	}
+
+	switch len(created) {
+	case 0:
+		return accountservice.Application{}, nil
+	case 1:
+	default:
+		return accountservice.Application{}, fmt.Errorf("%d applications named %q were created while the create "+
+			"was pending, so the one it made cannot be told apart", len(created), p.Name)
+	}
+
+	application, err := service.GetApplication(created[0].ID)
+	if err != nil {
+		return created[0], nil
+	}
+
+	return application, nil
+}
+
+// createUnconfirmedError is returned for a create which may or may not have
+// been applied, as the application could not be looked up afterwards.
+type createUnconfirmedError struct {
+	err error
+}
+
+func (e *createUnconfirmedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *createUnconfirmedError) Unwrap() error {
+	return e.err
+}
+
+// CreateApplication creates an application without duplicating it when the
+// API gives no definitive response. The application is then looked up with
+// pending, which must be recorded before the create, and returned if the API
+// did create it. When it cannot be looked up, a *createUnconfirmedError is
+// returned, and pending can be kept to look the application up later.
+//
+// Its key is empty when it was looked up and the API does not return it.
+func (c *accountClient) CreateApplication(ctx context.Context, req accountservice.CreateApplicationRequest, pending pendingCreate) (accountservice.Application, error) {
+	created, err := c.Service(ctx).CreateApplication(req)
+	if err == nil {
+		return accountservice.Application{ID: created.ID, Key: created.Key}, nil
+	}
+
+	if !isAmbiguousError(err) {
+		return accountservice.Application{}, err
+	}
+
+	tflog.Warn(ctx, "Create API Application failed without a definitive response, looking for it", map[string]interface{}{
+		"error": err.Error(),
+	})
+
+	lookupCtx, cancel := recoveryContext(ctx)
+	defer cancel()
+
+	application, lookupErr := pending.find(c.Service(lookupCtx))
+	if lookupErr != nil {
+		tflog.Warn(ctx, "Could not look for API Application after the failed create", map[string]interface{}{
+			"error": lookupErr.Error(),
+		})
+
+		return accountservice.Application{}, &createUnconfirmedError{err: err}
+	}
+
+	if len(application.ID) == 0 {
+		return accountservice.Application{}, err
+	}
+
+	tflog.Info(ctx, "Found API Application created before the failure", map[string]interface{}{
+		"id": application.ID,
+	})
+
+	return application, nil
+}
+
+// recoveryContext returns ctx, or a replacement bounded by
+// createRecoveryTimeout when ctx has already expired.
+func recoveryContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx.Err() == nil {
+		return ctx, func() {}
+	}
+
+	return context.WithTimeout(context.WithoutCancel(ctx), createRecoveryTimeout)
+}
+
+// isAmbiguousError reports whether err leaves it unknown if the request was
+// applied: the API did not respond, or failed with a server error.
+func isAmbiguousError(err error) bool {
+	statusCode, _ := apiResponseError(err)
+
+	return statusCode == 0 || statusCode >= http.StatusInternalServerError
+}
+
+// contextConnection wraps an APIConnection, attaching ctx to every request it invokes.
+type contextConnection struct {
+	*connection.APIConnection
+	ctx context.Context
+}
+
+func (c *contextConnection) Get(resource string, parameters connection.APIRequestParameters) (*connection.APIResponse, error) {
+	return c.Invoke(connection.APIRequest{
+		Method:     "GET",
+		Resource:   resource,
+		Parameters: parameters,
+	})
+}
+
+func (c *contextConnection) Post(resource string, body interface{}) (*connection.APIResponse, error) {
+	return c.Invoke(connection.APIRequest{
+		Method:   "POST",
+		Resource: resource,
+		Body:     body,
+	})
+}
+
+func (c *contextConnection) Put(resource string, body interface{}) (*connection.APIResponse, error) {
+	return c.Invoke(connection.APIRequest{
+		Method:   "PUT",
+		Resource: resource,
+		Body:     body,
+	})
+}
+
+func (c *contextConnection) Patch(resource string, body interface{}) (*connection.APIResponse, error) {
+	return c.Invoke(connection.APIRequest{
+		Method:   "PATCH",
+		Resource: resource,
+		Body:     body,
+	})
+}
+
+func (c *contextConnection) Delete(resource string, body interface{}) (*connection.APIResponse, error) {
+	return c.Invoke(connection.APIRequest{
+		Method:   "DELETE",
+		Resource: resource,
+		Body:     body,
+	})
+}
+
+func (c *contextConnection) Invoke(request connection.APIRequest) (*connection.APIResponse, error) {
+	req, err := c.NewRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.InvokeRequest(req.WithContext(c.ctx))
+}
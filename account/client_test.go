@@ -0,0 +1,361 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+)
+
+func TestAccountClient_contextDeadline(t *testing.T) {
+	client := testHangingAccountClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Service(ctx).GetApplication("app-1")
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context deadline exceeded, got %v", err)
+	}
+
+	diags := apiErrorDiagnostics("Error Retrieving API Application", err, applicationFields)
+	if len(diags) != 1 || diags[0].Summary() != "Error Retrieving API Application: Timed Out" {
+		t.Fatalf("expected timed out diagnostic, got %v", diags)
+	}
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("request was not aborted by its context, took %s", elapsed)
+	}
+}
+
+func TestAccountClient_contextCancelled(t *testing.T) {
+	client := testHangingAccountClient(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	err := client.Service(ctx).DeleteApplication("app-1")
+
+	diags := apiErrorDiagnostics("Error Deleting Application", err, applicationFields)
+	if len(diags) != 1 || diags[0].Summary() != "Error Deleting Application: Cancelled" {
+		t.Fatalf("expected cancelled diagnostic, got %v", diags)
+	}
+}
+
+func TestAccountClient_UpdateApplicationClearsDescription(t *testing.T) {
+	var body map[string]interface{}
+
+	client := testAccountClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/account/v1/applications/app-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	err := client.UpdateApplication(context.Background(), "app-1", accountservice.UpdateApplicationRequest{Name: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if description, ok := body["description"]; !ok || description != "" {
+		t.Errorf("expected empty description to be sent, got %v", body)
+	}
+}
+
+func TestAccountClient_UpdateApplicationScopesSerialised(t *testing.T) {
+	var mu sync.Mutex
+	stored := accountservice.ApplicationServiceMapping{Scopes: []accountservice.ApplicationServiceScope{}}
+
+	client := testAccountClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			mu.Lock()
+			body, _ := json.Marshal(map[string]interface{}{"data": stored})
+			mu.Unlock()
+
+			// Widen the window between reading and writing the scopes.
+			time.Sleep(10 * time.Millisecond)
+			_, _ = w.Write(body)
+		case http.MethodPut:
+			var req accountservice.SetServiceRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+
+			mu.Lock()
+			stored.Scopes = req.Scopes
+			mu.Unlock()
+
+			_, _ = w.Write([]byte(`{}`))
+		}
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			err := client.UpdateApplicationScopes(context.Background(), "app-1", func(scopes []accountservice.ApplicationServiceScope) ([]accountservice.ApplicationServiceScope, error) {
+				return append(scopes, accountservice.ApplicationServiceScope{Service: fmt.Sprintf("service-%d", i), Roles: []string{"read"}}), nil
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if len(stored.Scopes) != 10 {
+		t.Errorf("expected 10 scopes, got %d: %+v", len(stored.Scopes), stored.Scopes)
+	}
+}
+
+// testHangingAccountClient returns a client whose requests never receive a response.
+func testHangingAccountClient(t *testing.T) *accountClient {
+	release := make(chan struct{})
+
+	client := testAccountClient(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+	t.Cleanup(func() { close(release) })
+
+	return client
+}
+
+// testApplicationAPI is an in-memory stand-in for the Account API's
+// application endpoints. createStatus, when set, is returned by creates after
+// the application has been stored. outageOnCreate instead leaves creates
+// without a response until the client gives up, failing lists of applications
+// from then on. listStatus, when set, is returned by lists of applications.
+type testApplicationAPI struct {
+	mu             sync.Mutex
+	applications   []accountservice.Application
+	creates        int
+	updates        int
+	createStatus   int
+	listStatus     int
+	outageOnCreate bool
+}
+
+func (a *testApplicationAPI) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		write := func(data interface{}) {
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": data,
+				"meta": map[string]interface{}{"pagination": map[string]interface{}{"total_pages": 1}},
+			})
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/account/v1/applications":
+			var req accountservice.CreateApplicationRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+
+			a.creates++
+			application := accountservice.Application{
+				ID:          fmt.Sprintf("app-%d", a.creates),
+				Key:         fmt.Sprintf("key-%d", a.creates),
+				Name:        req.Name,
+				Description: req.Description,
+			}
+			a.applications = append(a.applications, application)
+
+			if a.outageOnCreate {
+				a.listStatus = http.StatusServiceUnavailable
+				a.mu.Unlock()
+				<-r.Context().Done()
+				a.mu.Lock()
+				return
+			}
+
+			if a.createStatus != 0 {
+				w.WriteHeader(a.createStatus)
+				return
+			}
+
+			w.WriteHeader(http.StatusCreated)
+			write(accountservice.CreateApplicationResponse{ID: application.ID, Key: application.Key})
+		case r.Method == http.MethodGet && r.URL.Path == "/account/v1/applications":
+			if a.listStatus != 0 {
+				w.WriteHeader(a.listStatus)
+				return
+			}
+
+			var matches []accountservice.Application
+			for _, application := range a.applications {
+				if application.Name == r.URL.Query().Get("name:eq") {
+					application.Key = ""
+					matches = append(matches, application)
+				}
+			}
+
+			write(matches)
+		default:
+			for i, application := range a.applications {
+				if r.URL.Path != "/account/v1/applications/"+application.ID {
+					continue
+				}
+
+				if r.Method == http.MethodPatch {
+					var req accountservice.UpdateApplicationRequest
+					if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+						t.Error(err)
+					}
+
+					a.updates++
+					a.applications[i].Name = req.Name
+					a.applications[i].Description = req.Description
+				}
+
+				write(a.applications[i])
+				return
+			}
+
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func TestAccountClient_CreateApplication(t *testing.T) {
+	portalKey := accountservice.Application{ID: "app-0", Name: "ci", Description: "portal key"}
+
+	cases := map[string]struct {
+		existing     []accountservice.Application
+		createStatus int
+		listStatus   int
+		expectedID   string
+		err          bool
+		unconfirmed  bool
+	}{
+		"created": {
+			expectedID: "app-1",
+		},
+		"created alongside an application of the same name": {
+			existing:   []accountservice.Application{portalKey},
+			expectedID: "app-1",
+		},
+		"ambiguous failure": {
+			existing:     []accountservice.Application{portalKey},
+			createStatus: http.StatusBadGateway,
+			expectedID:   "app-1",
+		},
+		"ambiguous failure which cannot be looked up": {
+			createStatus: http.StatusBadGateway,
+			listStatus:   http.StatusServiceUnavailable,
+			err:          true,
+			unconfirmed:  true,
+		},
+		"rejected": {
+			createStatus: http.StatusUnprocessableEntity,
+			err:          true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &testApplicationAPI{applications: c.existing, createStatus: c.createStatus}
+			client := testAccountClient(t, api.handler(t))
+			service := client.Service(context.Background())
+
+			pending, err := newPendingCreate(service, "ci")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			api.listStatus = c.listStatus
+
+			application, err := client.CreateApplication(context.Background(), accountservice.CreateApplicationRequest{
+				Name:        "ci",
+				Description: "deploys",
+			}, pending)
+
+			if api.creates != 1 || api.updates != 0 {
+				t.Errorf("expected a single create and no updates, got %d creates and %d updates", api.creates, api.updates)
+			}
+
+			var unconfirmed *createUnconfirmedError
+			if errors.As(err, &unconfirmed) != c.unconfirmed {
+				t.Errorf("expected unconfirmed=%t, got %v", c.unconfirmed, err)
+			}
+
+			if c.err {
+				if err == nil || len(application.ID) > 0 {
+					t.Fatalf("expected error without an application, got %+v", application)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if application.ID != c.expectedID || len(application.Key) == 0 {
+				t.Errorf("expected %s with its key, got %+v", c.expectedID, application)
+			}
+
+			for _, stored := range api.applications {
+				if stored.ID == c.expectedID && stored.Description != "deploys" {
+					t.Errorf("expected the description to be set by the create, got %q", stored.Description)
+				}
+			}
+		})
+	}
+}
+
+func TestPendingCreate_find(t *testing.T) {
+	cases := map[string]struct {
+		applications []accountservice.Application
+		expectedID   string
+		err          bool
+	}{
+		"none created": {
+			applications: []accountservice.Application{{ID: "app-0", Name: "ci"}},
+		},
+		"one created": {
+			applications: []accountservice.Application{{ID: "app-0", Name: "ci"}, {ID: "app-1", Name: "ci"}},
+			expectedID:   "app-1",
+		},
+		"several created": {
+			applications: []accountservice.Application{{ID: "app-0", Name: "ci"}, {ID: "app-1", Name: "ci"}, {ID: "app-2", Name: "ci"}},
+			err:          true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &testApplicationAPI{applications: c.applications}
+			service := testAccountClient(t, api.handler(t)).Service(context.Background())
+
+			application, err := pendingCreate{Name: "ci", Existing: []string{"app-0"}}.find(service)
+			if (err != nil) != c.err {
+				t.Fatalf("expected error=%t, got %v", c.err, err)
+			}
+
+			if application.ID != c.expectedID {
+				t.Errorf("expected %q, got %+v", c.expectedID, application)
+			}
+		})
+	}
+}
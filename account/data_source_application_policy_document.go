@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ApplicationPolicyDocumentDataSource{}
+
+func NewApplicationPolicyDocumentDataSource() datasource.DataSource {
+	return &ApplicationPolicyDocumentDataSource{}
+}
+
+// ApplicationPolicyDocumentDataSource defines the data source implementation.
+type ApplicationPolicyDocumentDataSource struct{}
+
+// ApplicationPolicyDocumentDataSourceModel describes the data source data model.
+type ApplicationPolicyDocumentDataSourceModel struct {
+	SourceDocuments   []types.String            `tfsdk:"source_documents"`
+	OverrideDocuments []types.String            `tfsdk:"override_documents"`
+	Services          []ApplicationServiceScope `tfsdk:"service"`
+	JSON              types.String              `tfsdk:"json"`
+}
+
+func (d *ApplicationPolicyDocumentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_policy_document"
+}
+
+// Schema defines the schema for the data source.
+func (d *ApplicationPolicyDocumentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"source_documents": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Policy documents to merge, adding their roles for the same service",
+			},
+			"override_documents": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Policy documents applied last, in order, each replacing the roles of earlier scopes for the same service",
+			},
+			"json": schema.StringAttribute{
+				Computed:    true,
+				Description: "Canonical JSON policy document, for use with policy_json on account_application_services",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"service": schema.ListNestedBlock{
+				Description: "Defines service access, replacing the roles of source documents for the same service",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of service",
+						},
+						"roles": schema.ListAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "List of service roles",
+						},
+					},
+				},
+			},
+		},
+		Description: "Composes service scopes into a JSON policy document. Source documents are merged first, " +
+			"then service blocks and finally override documents replace the roles for the services they define.",
+	}
+}
+
+func (d *ApplicationPolicyDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ApplicationPolicyDocumentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sources, diags := parsePolicyDocuments(path.Root("source_documents"), data.SourceDocuments)
+	resp.Diagnostics.Append(diags...)
+
+	overrides, diags := parsePolicyDocuments(path.Root("override_documents"), data.OverrideDocuments)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document, err := policyDocumentJSON(composePolicyDocument(sources, expandApplicationScope(ctx, data.Services), overrides))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Encoding Policy Document", err.Error())
+		return
+	}
+
+	data.JSON = types.StringValue(document)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parsePolicyDocuments decodes each document in documents, reporting errors
+// against its element of the attribute at p.
+func parsePolicyDocuments(p path.Path, documents []types.String) ([][]account.ApplicationServiceScope, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parsed := make([][]account.ApplicationServiceScope, 0, len(documents))
+
+	for i, document := range documents {
+		scopes, err := parsePolicyDocument(document.ValueString())
+		if err != nil {
+			diags.AddAttributeError(p.AtListIndex(i), "Invalid Policy Document", err.Error())
+			continue
+		}
+
+		parsed = append(parsed, scopes)
+	}
+
+	return parsed, diags
+}
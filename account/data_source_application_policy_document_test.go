@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApplicationPolicyDocumentDataSource_basic(t *testing.T) {
+	dataSourceName := "data.account_application_policy_document.test-policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "account_application_policy_document" "test-policy" {
+						source_documents = [
+							jsonencode({ scopes = [{ service = "ecloud", roles = ["read"] }] }),
+							jsonencode({ scopes = [{ service = "ecloud", roles = ["write"] }, { service = "account", roles = ["write"] }] }),
+						]
+						override_documents = [
+							jsonencode({ scopes = [{ service = "account", roles = ["read"] }] }),
+						]
+						service {
+							name  = "pss"
+							roles = ["read"]
+						}
+					}
+					`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "json",
+						`{"scopes":[{"service":"account","roles":["read"]},{"service":"ecloud","roles":["read","write"]},{"service":"pss","roles":["read"]}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestComposePolicyDocument(t *testing.T) {
+	sources := [][]accountservice.ApplicationServiceScope{
+		{{Service: "ecloud", Roles: []string{"read"}}},
+		{{Service: "ecloud", Roles: []string{"write"}}, {Service: "account", Roles: []string{"read", "write"}}},
+	}
+	explicit := []accountservice.ApplicationServiceScope{
+		{Service: "account", Roles: []string{"read"}},
+	}
+	overrides := [][]accountservice.ApplicationServiceScope{
+		{{Service: "ecloud", Roles: []string{"read"}}},
+		{{Service: "pss", Roles: []string{"write"}}},
+	}
+
+	expected := []accountservice.ApplicationServiceScope{
+		{Service: "account", Roles: []string{"read"}},
+		{Service: "ecloud", Roles: []string{"read"}},
+		{Service: "pss", Roles: []string{"write"}},
+	}
+
+	if actual := composePolicyDocument(sources, explicit, overrides); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+}
+
+func TestParsePolicyDocument(t *testing.T) {
+	cases := map[string]struct {
+		document string
+		expected []accountservice.ApplicationServiceScope
+		err      bool
+	}{
+		"canonical": {
+			document: `{"scopes":[{"service":"account","roles":["read"]}]}`,
+			expected: []accountservice.ApplicationServiceScope{{Service: "account", Roles: []string{"read"}}},
+		},
+		"unsorted with duplicates": {
+			document: `{"scopes":[{"service":"ecloud","roles":["write"]},{"service":"account","roles":["read"]},{"service":"ecloud","roles":["read","write"]}]}`,
+			expected: []accountservice.ApplicationServiceScope{
+				{Service: "account", Roles: []string{"read"}},
+				{Service: "ecloud", Roles: []string{"read", "write"}},
+			},
+		},
+		"missing service": {
+			document: `{"scopes":[{"roles":["read"]}]}`,
+			err:      true,
+		},
+		"unknown field": {
+			document: `{"statements":[]}`,
+			err:      true,
+		},
+		"not json": {
+			document: `scopes`,
+			err:      true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			actual, err := parsePolicyDocument(c.document)
+			if c.err {
+				if err == nil {
+					t.Fatalf("expected error, got %+v", actual)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("expected %+v, got %+v", c.expected, actual)
+			}
+
+			document, err := policyDocumentJSON(actual)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if reparsed, _ := parsePolicyDocument(document); !reflect.DeepEqual(reparsed, actual) {
+				t.Errorf("round trip changed scopes: %+v", reparsed)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ClientDataSource{}
+var _ datasource.DataSourceWithConfigure = &ClientDataSource{}
+
+func NewClientDataSource() datasource.DataSource {
+	return &ClientDataSource{}
+}
+
+// ClientDataSource defines the data source implementation.
+type ClientDataSource struct {
+	client *accountClient
+}
+
+func (d *ClientDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client"
+}
+
+// Schema defines the schema for the data source.
+func (d *ClientDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Required:    true,
+			Description: "ID of the client",
+		},
+		"created_date": schema.StringAttribute{
+			Computed:    true,
+			Description: "Date the client was created",
+		},
+	}
+
+	for name, description := range clientAttributeDescriptions {
+		attributes[name] = schema.StringAttribute{
+			Computed:    true,
+			Description: description,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes:  attributes,
+		Description: "Retrieves a client account of a reseller.",
+	}
+}
+
+func (d *ClientDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*accountClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ClientDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.Append(unconfiguredClientDiagnostics()...)
+		return
+	}
+
+	var data ClientModel
+	service := d.client.Service(ctx)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Retrieving Client", map[string]interface{}{
+		"id": data.ID.ValueInt64(),
+	})
+
+	client, err := service.GetClient(int(data.ID.ValueInt64()))
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Client", err, nil)...)
+		return
+	}
+
+	data = readClient(client)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ContactDataSource{}
+var _ datasource.DataSourceWithConfigure = &ContactDataSource{}
+
+func NewContactDataSource() datasource.DataSource {
+	return &ContactDataSource{}
+}
+
+// ContactDataSource defines the data source implementation.
+type ContactDataSource struct {
+	client *accountClient
+}
+
+// contactFields maps Account API fields to attributes for validation errors.
+var contactFields = map[string]path.Path{
+	"id": path.Root("id"),
+}
+
+func (d *ContactDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contact"
+}
+
+// Schema defines the schema for the data source.
+func (d *ContactDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the contact",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of contact, e.g. 'Primary Contact', 'Accounts' or 'Technical'",
+			},
+			"first_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "First name of the contact",
+			},
+			"last_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last name of the contact",
+			},
+		},
+		Description: "Retrieves a single account contact.",
+	}
+}
+
+func (d *ContactDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*accountClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ContactDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.Append(unconfiguredClientDiagnostics()...)
+		return
+	}
+
+	var data ContactModel
+	service := d.client.Service(ctx)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Retrieving Contact", map[string]interface{}{
+		"id": data.ID.ValueInt64(),
+	})
+
+	contact, err := service.GetContact(int(data.ID.ValueInt64()))
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Contact", err, contactFields)...)
+		return
+	}
+
+	data = readContact(contact)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
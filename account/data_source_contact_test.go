@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccContactDataSource_basic(t *testing.T) {
+	dataSourceName := "data.account_contact.test-contact"
+	listDataSourceName := "data.account_contacts.test-contacts"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccDataSourceContactConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(listDataSourceName, "contacts.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", listDataSourceName, "contacts.0.id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "type", listDataSourceName, "contacts.0.type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "first_name", listDataSourceName, "contacts.0.first_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "last_name", listDataSourceName, "contacts.0.last_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceContactConfig_basic() string {
+	return `
+		data "account_contacts" "test-contacts" {}
+
+		data "account_contact" "test-contact" {
+			id = data.account_contacts.test-contacts.contacts[0].id
+		}
+		`
+}
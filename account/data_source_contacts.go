@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ans-group/sdk-go/pkg/connection"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ContactsDataSource{}
+var _ datasource.DataSourceWithConfigure = &ContactsDataSource{}
+
+func NewContactsDataSource() datasource.DataSource {
+	return &ContactsDataSource{}
+}
+
+// ContactsDataSource defines the data source implementation.
+type ContactsDataSource struct {
+	client *accountClient
+}
+
+// ContactsDataSourceModel describes the data source data model.
+type ContactsDataSourceModel struct {
+	Type     types.String   `tfsdk:"type"`
+	Contacts []ContactModel `tfsdk:"contacts"`
+}
+
+func (d *ContactsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contacts"
+}
+
+// Schema defines the schema for the data source.
+func (d *ContactsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return contacts of this type, e.g. 'Primary Contact', 'Accounts' or 'Technical'. An empty string applies no filter",
+			},
+			"contacts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Account contacts",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the contact",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of contact",
+						},
+						"first_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "First name of the contact",
+						},
+						"last_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Last name of the contact",
+						},
+					},
+				},
+			},
+		},
+		Description: "Retrieves the account contacts, optionally filtered by type.",
+	}
+}
+
+func (d *ContactsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*accountClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ContactsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.Append(unconfiguredClientDiagnostics()...)
+		return
+	}
+
+	var data ContactsDataSourceModel
+	service := d.client.Service(ctx)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := connection.APIRequestParameters{}
+	if len(data.Type.ValueString()) > 0 {
+		params.Filtering = []connection.APIRequestFiltering{
+			*connection.NewAPIRequestFiltering("type", connection.EQOperator, []string{data.Type.ValueString()}),
+		}
+	}
+
+	tflog.Info(ctx, "Retrieving Contacts", map[string]interface{}{
+		"type": data.Type.ValueString(),
+	})
+
+	contacts, err := service.GetContacts(params)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Contacts", err, nil)...)
+		return
+	}
+
+	data.Contacts = readContacts(contacts)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
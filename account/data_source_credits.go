@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ans-group/sdk-go/pkg/connection"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CreditsDataSource{}
+var _ datasource.DataSourceWithConfigure = &CreditsDataSource{}
+
+func NewCreditsDataSource() datasource.DataSource {
+	return &CreditsDataSource{}
+}
+
+// CreditsDataSource defines the data source implementation.
+type CreditsDataSource struct {
+	client *accountClient
+}
+
+// CreditsDataSourceModel describes the data source data model.
+type CreditsDataSourceModel struct {
+	Type              types.String  `tfsdk:"type"`
+	MinimumRemaining  types.Int64   `tfsdk:"minimum_remaining"`
+	ErrorBelowMinimum types.Bool    `tfsdk:"error_below_minimum"`
+	Credits           []CreditModel `tfsdk:"credits"`
+}
+
+// CreditModel describes a single credit balance.
+type CreditModel struct {
+	Type      types.String `tfsdk:"type"`
+	Total     types.Int64  `tfsdk:"total"`
+	Used      types.Int64  `tfsdk:"used"`
+	Remaining types.Int64  `tfsdk:"remaining"`
+}
+
+func (d *CreditsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credits"
+}
+
+// Schema defines the schema for the data source.
+func (d *CreditsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return credits of this type. An empty string applies no filter",
+			},
+			"minimum_remaining": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Report a diagnostic for any returned credit with fewer remaining than this",
+			},
+			"error_below_minimum": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Report credits below minimum_remaining as an error rather than a warning. Defaults to false",
+			},
+			"credits": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Prepaid credit balances",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of credit",
+						},
+						"total": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Total credits purchased",
+						},
+						"used": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Credits used",
+						},
+						"remaining": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Credits remaining",
+						},
+					},
+				},
+			},
+		},
+		Description: "Retrieves prepaid credit balances, optionally checking they have not dropped below a minimum.",
+	}
+}
+
+func (d *CreditsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*accountClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CreditsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.Append(unconfiguredClientDiagnostics()...)
+		return
+	}
+
+	var data CreditsDataSourceModel
+	service := d.client.Service(ctx)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := connection.APIRequestParameters{}
+	if len(data.Type.ValueString()) > 0 {
+		params.Filtering = []connection.APIRequestFiltering{
+			*connection.NewAPIRequestFiltering("type", connection.EQOperator, []string{data.Type.ValueString()}),
+		}
+	}
+
+	tflog.Info(ctx, "Retrieving Credits", map[string]interface{}{
+		"type": data.Type.ValueString(),
+	})
+
+	credits, err := service.GetCredits(params)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Credits", err, nil)...)
+		return
+	}
+
+	data.Credits = make([]CreditModel, len(credits))
+
+	for i, credit := range credits {
+		data.Credits[i] = CreditModel{
+			Type:      types.StringValue(credit.Type),
+			Total:     types.Int64Value(int64(credit.Total)),
+			Used:      types.Int64Value(int64(credit.Total - credit.Remaining)),
+			Remaining: types.Int64Value(int64(credit.Remaining)),
+		}
+
+		if data.MinimumRemaining.IsNull() || int64(credit.Remaining) >= data.MinimumRemaining.ValueInt64() {
+			continue
+		}
+
+		summary := "Credits Below Minimum"
+		detail := fmt.Sprintf("%d %s credits remain, below the minimum of %d.", credit.Remaining, credit.Type, data.MinimumRemaining.ValueInt64())
+
+		if data.ErrorBelowMinimum.ValueBool() {
+			resp.Diagnostics.AddAttributeError(path.Root("minimum_remaining"), summary, detail)
+		} else {
+			resp.Diagnostics.AddAttributeWarning(path.Root("minimum_remaining"), summary, detail)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
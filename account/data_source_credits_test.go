@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCreditsDataSource_basic(t *testing.T) {
+	dataSourceName := "data.account_credits.test-credits"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "account_credits" "test-credits" {
+						minimum_remaining = 0
+					}
+					`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "credits.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestCreditsDataSource_minimumRemaining(t *testing.T) {
+	cases := []struct {
+		name              string
+		minimumRemaining  types.Int64
+		errorBelowMinimum types.Bool
+		severity          diag.Severity
+	}{
+		{
+			name:              "no minimum",
+			minimumRemaining:  types.Int64Null(),
+			errorBelowMinimum: types.BoolNull(),
+		},
+		{
+			name:              "below minimum",
+			minimumRemaining:  types.Int64Value(6),
+			errorBelowMinimum: types.BoolNull(),
+			severity:          diag.SeverityWarning,
+		},
+		{
+			name:              "below minimum with error_below_minimum",
+			minimumRemaining:  types.Int64Value(6),
+			errorBelowMinimum: types.BoolValue(true),
+			severity:          diag.SeverityError,
+		},
+		{
+			name:              "at minimum",
+			minimumRemaining:  types.Int64Value(5),
+			errorBelowMinimum: types.BoolValue(true),
+		},
+		{
+			name:              "above minimum",
+			minimumRemaining:  types.Int64Value(4),
+			errorBelowMinimum: types.BoolValue(true),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			d := &CreditsDataSource{
+				client: testAccountClient(t, func(w http.ResponseWriter, r *http.Request) {
+					_, _ = w.Write([]byte(`{"data":[{"type":"sms","total":100,"remaining":5}],"meta":{"pagination":{"total_pages":1}}}`))
+				}),
+			}
+
+			var schemaResp datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+			config := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			diags := config.Set(ctx, &CreditsDataSourceModel{
+				Type:              types.StringNull(),
+				MinimumRemaining:  c.minimumRemaining,
+				ErrorBelowMinimum: c.errorBelowMinimum,
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected error building config: %v", diags)
+			}
+
+			resp := datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema},
+			}
+			d.Read(ctx, datasource.ReadRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw},
+			}, &resp)
+
+			if c.severity == diag.SeverityInvalid {
+				if len(resp.Diagnostics) != 0 {
+					t.Fatalf("expected no diagnostics, got %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if len(resp.Diagnostics) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %v", resp.Diagnostics)
+			}
+
+			got := resp.Diagnostics[0]
+			if got.Severity() != c.severity || got.Summary() != "Credits Below Minimum" {
+				t.Errorf("expected %s %q, got %s %q", c.severity, "Credits Below Minimum", got.Severity(), got.Summary())
+			}
+
+			withPath, ok := got.(diag.DiagnosticWithPath)
+			if !ok || !withPath.Path().Equal(path.Root("minimum_remaining")) {
+				t.Errorf("expected diagnostic on minimum_remaining, got %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DetailsDataSource{}
+var _ datasource.DataSourceWithConfigure = &DetailsDataSource{}
+
+func NewDetailsDataSource() datasource.DataSource {
+	return &DetailsDataSource{}
+}
+
+// DetailsDataSource defines the data source implementation.
+type DetailsDataSource struct {
+	client *accountClient
+}
+
+// DetailsDataSourceModel describes the data source data model.
+type DetailsDataSourceModel struct {
+	CompanyRegistrationNumber types.String `tfsdk:"company_registration_number"`
+	VATIdentificationNumber   types.String `tfsdk:"vat_identification_number"`
+	PrimaryContactID          types.Int64  `tfsdk:"primary_contact_id"`
+}
+
+func (d *DetailsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_details"
+}
+
+// Schema defines the schema for the data source.
+func (d *DetailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"company_registration_number": schema.StringAttribute{
+				Computed:    true,
+				Description: "Company registration number of the account",
+			},
+			"vat_identification_number": schema.StringAttribute{
+				Computed:    true,
+				Description: "VAT identification number of the account",
+			},
+			"primary_contact_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "ID of the account's primary contact",
+			},
+		},
+		Description: "Retrieves the company details of the account the provider is authenticated against.",
+	}
+}
+
+func (d *DetailsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*accountClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.Append(unconfiguredClientDiagnostics()...)
+		return
+	}
+
+	service := d.client.Service(ctx)
+
+	tflog.Info(ctx, "Retrieving Account Details")
+
+	details, err := service.GetDetails()
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Account Details", err, nil)...)
+		return
+	}
+
+	data := DetailsDataSourceModel{
+		CompanyRegistrationNumber: types.StringValue(details.CompanyRegistrationNumber),
+		VATIdentificationNumber:   types.StringValue(details.VATIdentificationNumber),
+		PrimaryContactID:          types.Int64Value(int64(details.PrimaryContactID)),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
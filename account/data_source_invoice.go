@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InvoiceDataSource{}
+var _ datasource.DataSourceWithConfigure = &InvoiceDataSource{}
+
+func NewInvoiceDataSource() datasource.DataSource {
+	return &InvoiceDataSource{}
+}
+
+// InvoiceDataSource defines the data source implementation.
+type InvoiceDataSource struct {
+	client *accountClient
+}
+
+// invoiceFields maps Account API fields to attributes for validation errors.
+var invoiceFields = map[string]path.Path{
+	"id": path.Root("id"),
+}
+
+func (d *InvoiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invoice"
+}
+
+// Schema defines the schema for the data source.
+func (d *InvoiceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Required:    true,
+				Description: "ID of the invoice",
+			},
+			"date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date of the invoice, in YYYY-MM-DD format",
+			},
+			"paid": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the invoice has been paid",
+			},
+			"net": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Net total of the invoice",
+			},
+			"vat": schema.Float64Attribute{
+				Computed:    true,
+				Description: "VAT total of the invoice",
+			},
+			"gross": schema.Float64Attribute{
+				Computed:    true,
+				Description: "Gross total of the invoice",
+			},
+		},
+		Description: "Retrieves a single invoice.",
+	}
+}
+
+func (d *InvoiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*accountClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InvoiceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.Append(unconfiguredClientDiagnostics()...)
+		return
+	}
+
+	var data InvoiceModel
+	service := d.client.Service(ctx)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Retrieving Invoice", map[string]interface{}{
+		"id": data.ID.ValueInt64(),
+	})
+
+	invoice, err := service.GetInvoice(int(data.ID.ValueInt64()))
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Invoice", err, invoiceFields)...)
+		return
+	}
+
+	data = readInvoice(invoice)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccInvoiceDataSource_basic(t *testing.T) {
+	dataSourceName := "data.account_invoice.test-invoice"
+	listDataSourceName := "data.account_invoices.test-invoices"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccDataSourceInvoiceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(listDataSourceName, "invoices.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", listDataSourceName, "invoices.0.id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "date", listDataSourceName, "invoices.0.date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "gross", listDataSourceName, "invoices.0.gross"),
+					resource.TestCheckResourceAttr(dataSourceName, "paid", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceInvoiceConfig_basic() string {
+	return `
+		data "account_invoices" "test-invoices" {
+			paid       = true
+			date_after = "2000-01-01"
+		}
+
+		data "account_invoice" "test-invoice" {
+			id = data.account_invoices.test-invoices.invoices[0].id
+		}
+		`
+}
+
+func TestExpandInvoiceFilters(t *testing.T) {
+	cases := map[string]struct {
+		data     InvoicesDataSourceModel
+		expected int
+	}{
+		"unset": {
+			data: InvoicesDataSourceModel{DateAfter: types.StringNull(), DateBefore: types.StringNull()},
+		},
+		"empty dates": {
+			data: InvoicesDataSourceModel{DateAfter: types.StringValue(""), DateBefore: types.StringValue("")},
+		},
+		"dates": {
+			data:     InvoicesDataSourceModel{DateAfter: types.StringValue("2024-01-01"), DateBefore: types.StringValue("2024-02-01")},
+			expected: 2,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if actual := expandInvoiceFilters(c.data); len(actual) != c.expected {
+				t.Errorf("expected %d filters, got %+v", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ans-group/sdk-go/pkg/connection"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InvoicesDataSource{}
+var _ datasource.DataSourceWithConfigure = &InvoicesDataSource{}
+
+func NewInvoicesDataSource() datasource.DataSource {
+	return &InvoicesDataSource{}
+}
+
+// InvoicesDataSource defines the data source implementation.
+type InvoicesDataSource struct {
+	client *accountClient
+}
+
+// invoicesFields maps Account API filter fields to attributes for validation errors.
+var invoicesFields = map[string]path.Path{
+	"paid": path.Root("paid"),
+}
+
+// InvoicesDataSourceModel describes the data source data model.
+type InvoicesDataSourceModel struct {
+	Paid             types.Bool     `tfsdk:"paid"`
+	DateAfter        types.String   `tfsdk:"date_after"`
+	DateBefore       types.String   `tfsdk:"date_before"`
+	TotalGreaterThan types.Float64  `tfsdk:"total_greater_than"`
+	TotalLessThan    types.Float64  `tfsdk:"total_less_than"`
+	Invoices         []InvoiceModel `tfsdk:"invoices"`
+}
+
+func (d *InvoicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invoices"
+}
+
+// Schema defines the schema for the data source.
+func (d *InvoicesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"paid": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return paid (true) or unpaid (false) invoices",
+			},
+			"date_after": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return invoices dated after this date, in YYYY-MM-DD format. An empty string applies no filter",
+			},
+			"date_before": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return invoices dated before this date, in YYYY-MM-DD format. An empty string applies no filter",
+			},
+			"total_greater_than": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Only return invoices with a gross total greater than this amount",
+			},
+			"total_less_than": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Only return invoices with a gross total less than this amount",
+			},
+			"invoices": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Invoices matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "ID of the invoice",
+						},
+						"date": schema.StringAttribute{
+							Computed:    true,
+							Description: "Date of the invoice, in YYYY-MM-DD format",
+						},
+						"paid": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the invoice has been paid",
+						},
+						"net": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Net total of the invoice",
+						},
+						"vat": schema.Float64Attribute{
+							Computed:    true,
+							Description: "VAT total of the invoice",
+						},
+						"gross": schema.Float64Attribute{
+							Computed:    true,
+							Description: "Gross total of the invoice",
+						},
+					},
+				},
+			},
+		},
+		Description: "Retrieves the account invoices, optionally filtered by date, paid status and total.",
+	}
+}
+
+func (d *InvoicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*accountClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InvoicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.Append(unconfiguredClientDiagnostics()...)
+		return
+	}
+
+	var data InvoicesDataSourceModel
+	service := d.client.Service(ctx)
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := connection.APIRequestParameters{
+		Filtering: expandInvoiceFilters(data),
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Retrieving Invoices: %+v", params.Filtering))
+
+	// GetInvoices follows pagination until every matching invoice is retrieved.
+	invoices, err := service.GetInvoices(params)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Invoices", err, invoicesFields)...)
+		return
+	}
+
+	data.Invoices = readInvoices(invoices)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func expandInvoiceFilters(data InvoicesDataSourceModel) []connection.APIRequestFiltering {
+	var filters []connection.APIRequestFiltering
+
+	if !data.Paid.IsNull() {
+		filters = append(filters, *connection.NewAPIRequestFiltering("paid", connection.EQOperator, []string{strconv.FormatBool(data.Paid.ValueBool())}))
+	}
+
+	if len(data.DateAfter.ValueString()) > 0 {
+		filters = append(filters, *connection.NewAPIRequestFiltering("date", connection.GTOperator, []string{data.DateAfter.ValueString()}))
+	}
+
+	if len(data.DateBefore.ValueString()) > 0 {
+		filters = append(filters, *connection.NewAPIRequestFiltering("date", connection.LTOperator, []string{data.DateBefore.ValueString()}))
+	}
+
+	if !data.TotalGreaterThan.IsNull() {
+		filters = append(filters, *connection.NewAPIRequestFiltering("gross", connection.GTOperator, []string{strconv.FormatFloat(data.TotalGreaterThan.ValueFloat64(), 'f', -1, 64)}))
+	}
+
+	if !data.TotalLessThan.IsNull() {
+		filters = append(filters, *connection.NewAPIRequestFiltering("gross", connection.LTOperator, []string{strconv.FormatFloat(data.TotalLessThan.ValueFloat64(), 'f', -1, 64)}))
+	}
+
+	return filters
+}
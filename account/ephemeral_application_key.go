@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &ApplicationKeyEphemeral{}
+var _ ephemeral.EphemeralResourceWithConfigure = &ApplicationKeyEphemeral{}
+var _ ephemeral.EphemeralResourceWithClose = &ApplicationKeyEphemeral{}
+var _ ephemeral.EphemeralResourceWithValidateConfig = &ApplicationKeyEphemeral{}
+
+// applicationKeyPrivateKey is the private data key holding the ID of the
+// temporary application, so that Close can delete it.
+const applicationKeyPrivateKey = "application_id"
+
+func NewApplicationKeyEphemeral() ephemeral.EphemeralResource {
+	return &ApplicationKeyEphemeral{}
+}
+
+// ApplicationKeyEphemeral defines the ephemeral resource implementation.
+type ApplicationKeyEphemeral struct {
+	client *accountClient
+}
+
+// applicationKeyFields maps Account API fields to attributes for validation errors.
+var applicationKeyFields = map[string]path.Path{
+	"name":                path.Root("name"),
+	"description":         path.Root("description"),
+	"scopes":              path.Root("service"),
+	"ip_restriction_type": path.Root("restriction").AtName("type"),
+	"ip_ranges":           path.Root("restriction").AtName("ranges"),
+}
+
+// ApplicationKeyEphemeralModel describes the ephemeral resource data model.
+type ApplicationKeyEphemeralModel struct {
+	ID            types.String               `tfsdk:"id"`
+	ApplicationID types.String               `tfsdk:"application_id"`
+	Key           types.String               `tfsdk:"key"`
+	Name          types.String               `tfsdk:"name"`
+	Description   types.String               `tfsdk:"description"`
+	Services      []ApplicationServiceScope  `tfsdk:"service"`
+	Restriction   *ApplicationKeyRestriction `tfsdk:"restriction"`
+}
+
+// ApplicationKeyRestriction describes the IP restriction of a temporary application.
+type ApplicationKeyRestriction struct {
+	Type   types.String   `tfsdk:"type"`
+	Ranges []types.String `tfsdk:"ranges"`
+}
+
+func (r *ApplicationKeyEphemeral) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_key"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (r *ApplicationKeyEphemeral) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "ID of the application",
+			},
+			"application_id": schema.StringAttribute{
+				Optional: true,
+				Description: "ID of an existing application to fetch the key of, such as an account_application " +
+					"with key_wo_version set. The application is not deleted on close. Conflicts with name, service and restriction.",
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "API Key",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Application name. Required unless application_id is set.",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Application description",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"service": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Service name",
+						},
+						"roles": schema.ListAttribute{
+							ElementType: types.StringType,
+							Required:    true,
+							Description: "Roles granted on the service",
+						},
+					},
+				},
+				Description: "Services the key is granted access to",
+			},
+			"restriction": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Optional:    true,
+						Description: "Restriction type, allowlist or denylist",
+					},
+					"ranges": schema.ListAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "IP ranges to allow or deny",
+					},
+				},
+				Description: "IP restriction applied to the key",
+			},
+		},
+		Description: "Creates a temporary API application for the duration of a Terraform run and returns its key. " +
+			"The application is deleted when Terraform closes the ephemeral resource, and the key is never stored in state. " +
+			"With application_id set, fetches the key of an existing application instead.",
+	}
+}
+
+func (r *ApplicationKeyEphemeral) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*accountClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ApplicationKeyEphemeral) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var d ApplicationKeyEphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() || d.ApplicationID.IsUnknown() {
+		return
+	}
+
+	if d.ApplicationID.IsNull() {
+		if d.Name.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Missing Application Name",
+				"name must be set to create a temporary application, unless application_id is set.")
+		}
+		return
+	}
+
+	conflicting := map[string]bool{
+		"name":        !d.Name.IsNull(),
+		"description": !d.Description.IsNull(),
+		"service":     len(d.Services) > 0,
+		"restriction": d.Restriction != nil,
+	}
+
+	for attribute, set := range conflicting {
+		if set {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute), "Conflicting Application Key Configuration",
+				fmt.Sprintf("%s cannot be set with application_id, as the key of an existing application is fetched unchanged.", attribute))
+		}
+	}
+}
+
+func (r *ApplicationKeyEphemeral) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		resp.Diagnostics.Append(unconfiguredClientDiagnostics()...)
+		return
+	}
+
+	var d ApplicationKeyEphemeralModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service := r.client.Service(ctx)
+
+	if !d.ApplicationID.IsNull() {
+		r.openExisting(ctx, service, d, resp)
+		return
+	}
+
+	createReq := accountservice.CreateApplicationRequest{
+		Name:        d.Name.ValueString(),
+		Description: d.Description.ValueString(),
+	}
+
+	tflog.Info(ctx, "Creating temporary API Application")
+	createData, err := service.CreateApplication(createReq)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating API Application", err, applicationKeyFields)...)
+		return
+	}
+
+	if len(d.Services) > 0 {
+		tflog.Info(ctx, "Setting temporary API Application Services")
+		err = service.SetApplicationServices(createData.ID, accountservice.SetServiceRequest{
+			Scopes: expandApplicationScope(ctx, d.Services),
+		})
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Setting Application Services", err, applicationKeyFields)...)
+			r.deleteApplication(ctx, service, createData.ID, resp)
+			return
+		}
+	}
+
+	if d.Restriction != nil && !d.Restriction.Type.IsNull() {
+		tflog.Info(ctx, "Setting temporary API Application Restriction")
+		err = service.SetApplicationRestrictions(createData.ID, accountservice.SetRestrictionRequest{
+			IPRestrictionType: d.Restriction.Type.ValueString(),
+			IPRanges:          expandArray(ctx, d.Restriction.Ranges),
+		})
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Setting Application Restrictions", err, applicationKeyFields)...)
+			r.deleteApplication(ctx, service, createData.ID, resp)
+			return
+		}
+	}
+
+	applicationID, err := json.Marshal(createData.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Storing API Application ID", err.Error())
+		r.deleteApplication(ctx, service, createData.ID, resp)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, applicationKeyPrivateKey, applicationID)...)
+
+	d.ID = types.StringValue(createData.ID)
+	d.Key = types.StringValue(createData.Key)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &d)...)
+}
+
+// openExisting fetches the key of an existing application. Nothing is stored
+// in private data, so Close leaves the application in place.
+func (r *ApplicationKeyEphemeral) openExisting(ctx context.Context, service accountservice.AccountService, d ApplicationKeyEphemeralModel, resp *ephemeral.OpenResponse) {
+	tflog.Info(ctx, "Retrieving API Application key", map[string]interface{}{
+		"id": d.ApplicationID.ValueString(),
+	})
+
+	application, err := service.GetApplication(d.ApplicationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving API Application", err, nil)...)
+		return
+	}
+
+	if len(application.Key) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("application_id"), "API Application Key Not Available",
+			fmt.Sprintf("The Account API did not return a key for application %s.", application.ID))
+		return
+	}
+
+	d.ID = types.StringValue(application.ID)
+	d.Key = types.StringValue(application.Key)
+	d.Name = types.StringValue(application.Name)
+	d.Description = types.StringValue(application.Description)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &d)...)
+}
+
+// deleteApplication removes a temporary application that could not be fully
+// configured, so that a failed Open does not leave a usable key behind.
+func (r *ApplicationKeyEphemeral) deleteApplication(ctx context.Context, service accountservice.AccountService, id string, resp *ephemeral.OpenResponse) {
+	tflog.Info(ctx, "Deleting temporary API Application after failed open", map[string]interface{}{
+		"id": id,
+	})
+
+	err := service.DeleteApplication(id)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Deleting API Application", err, nil)...)
+	}
+}
+
+func (r *ApplicationKeyEphemeral) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	if r.client == nil {
+		resp.Diagnostics.Append(unconfiguredClientDiagnostics()...)
+		return
+	}
+
+	applicationID, diags := req.Private.GetKey(ctx, applicationKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || applicationID == nil {
+		return
+	}
+
+	var id string
+	if err := json.Unmarshal(applicationID, &id); err != nil {
+		resp.Diagnostics.AddError("Error Reading API Application ID", err.Error())
+		return
+	}
+
+	service := r.client.Service(ctx)
+
+	tflog.Info(ctx, "Deleting temporary API Application", map[string]interface{}{
+		"id": id,
+	})
+
+	err := service.DeleteApplication(id)
+
+	if isNotFoundError(err) {
+		tflog.Info(ctx, "Temporary API Application already deleted")
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Deleting API Application", err, nil)...)
+	}
+}
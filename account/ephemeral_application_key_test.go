@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testApplicationKeyConfig builds ephemeral resource configuration from d.
+func testApplicationKeyConfig(t *testing.T, r ephemeral.EphemeralResource, d ApplicationKeyEphemeralModel) tfsdk.Config {
+	ctx := context.Background()
+
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, &d); diags.HasError() {
+		t.Fatalf("unexpected error building config: %v", diags)
+	}
+
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    state.Raw,
+	}
+}
+
+func TestAccApplicationKeyEphemeral_basic(t *testing.T) {
+	applicationName := acctest.RandomWithPrefix("tftest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"account": providerserver.NewProtocol6WithError(New("test")()),
+			"echo":    echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccEphemeralApplicationKeyConfig_basic(applicationName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact(applicationName)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("key"), knownvalue.StringRegexp(regexp.MustCompile(`.+`))),
+				},
+			},
+		},
+	})
+}
+
+func TestApplicationKeyEphemeral_existingApplication(t *testing.T) {
+	ctx := context.Background()
+	r := &ApplicationKeyEphemeral{
+		client: testAccountClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("unexpected %s request, the existing application must not be changed", r.Method)
+			}
+
+			_, _ = w.Write([]byte(`{"data":{"id":"app-1","key":"secret","name":"ci","description":"managed"}}`))
+		}),
+	}
+
+	config := testApplicationKeyConfig(t, r, ApplicationKeyEphemeralModel{
+		ApplicationID: types.StringValue("app-1"),
+	})
+
+	resp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: config.Schema,
+			Raw:    tftypes.NewValue(config.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Open(ctx, ephemeral.OpenRequest{Config: config}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var d ApplicationKeyEphemeralModel
+	if diags := resp.Result.Get(ctx, &d); diags.HasError() {
+		t.Fatalf("unexpected error reading result: %v", diags)
+	}
+
+	if d.Key.ValueString() != "secret" || d.Name.ValueString() != "ci" {
+		t.Errorf("unexpected result %+v", d)
+	}
+}
+
+func TestApplicationKeyEphemeral_existingApplicationWithoutKey(t *testing.T) {
+	ctx := context.Background()
+	r := &ApplicationKeyEphemeral{
+		client: testAccountClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data":{"id":"app-1","name":"ci","description":"managed"}}`))
+		}),
+	}
+
+	config := testApplicationKeyConfig(t, r, ApplicationKeyEphemeralModel{
+		ApplicationID: types.StringValue("app-1"),
+	})
+
+	resp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Schema: config.Schema,
+			Raw:    tftypes.NewValue(config.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.Open(ctx, ephemeral.OpenRequest{Config: config}, &resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "API Application Key Not Available" {
+		t.Fatalf("expected the missing key to be reported, got %v", resp.Diagnostics)
+	}
+
+	if !resp.Result.Raw.IsNull() {
+		t.Errorf("expected no result without a key, got %s", resp.Result.Raw)
+	}
+}
+
+func TestApplicationKeyEphemeral_unconfigured(t *testing.T) {
+	ctx := context.Background()
+	r := &ApplicationKeyEphemeral{}
+
+	openResp := ephemeral.OpenResponse{}
+	r.Open(ctx, ephemeral.OpenRequest{}, &openResp)
+
+	if !openResp.Diagnostics.HasError() {
+		t.Error("expected an error opening without a configured client")
+	}
+
+	closeResp := ephemeral.CloseResponse{}
+	r.Close(ctx, ephemeral.CloseRequest{}, &closeResp)
+
+	if !closeResp.Diagnostics.HasError() {
+		t.Error("expected an error closing without a configured client")
+	}
+}
+
+func TestApplicationKeyEphemeral_validateConfig(t *testing.T) {
+	r := &ApplicationKeyEphemeral{}
+
+	cases := map[string]struct {
+		model  ApplicationKeyEphemeralModel
+		errors int
+	}{
+		"name": {
+			model: ApplicationKeyEphemeralModel{Name: types.StringValue("ci")},
+		},
+		"application_id": {
+			model: ApplicationKeyEphemeralModel{ApplicationID: types.StringValue("app-1")},
+		},
+		"neither": {
+			errors: 1,
+		},
+		"both": {
+			model: ApplicationKeyEphemeralModel{
+				ApplicationID: types.StringValue("app-1"),
+				Name:          types.StringValue("ci"),
+				Restriction:   &ApplicationKeyRestriction{Type: types.StringValue("allowlist")},
+			},
+			errors: 2,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := ephemeral.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), ephemeral.ValidateConfigRequest{Config: testApplicationKeyConfig(t, r, c.model)}, &resp)
+
+			if resp.Diagnostics.ErrorsCount() != c.errors {
+				t.Errorf("expected %d errors, got %v", c.errors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func testAccEphemeralApplicationKeyConfig_basic(name string) string {
+	return fmt.Sprintf(`
+ephemeral "account_application_key" "test" {
+  name = %[1]q
+
+  service {
+    name  = "account"
+    roles = ["read"]
+  }
+
+  restriction {
+    type   = "allowlist"
+    ranges = ["1.1.1.1/32"]
+  }
+}
+
+provider "echo" {
+  data = ephemeral.account_application_key.test
+}
+
+resource "echo" "test" {}
+`, name)
+}
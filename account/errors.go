@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ans-group/sdk-go/pkg/connection"
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// statusCodePattern matches the status code the SDK embeds in errors for
+// non-2xx responses. As of sdk-go v1.20.4, APIResponse.HandleResponse returns
+// fmt.Errorf("unexpected status code (%d): %w", code, body), where body is the
+// decoded *connection.APIResponseBodyData[T]; TestApiResponseError pins this.
+var statusCodePattern = regexp.MustCompile(`unexpected status code \((\d+)\)`)
+
+// unconfiguredClientDiagnostics reports a read before the provider has
+// configured its Account API client.
+func unconfiguredClientDiagnostics() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		"Unconfigured Account API Client",
+		"Expected a configured Account API client. Please report this issue to the provider developers.",
+	)
+
+	return diags
+}
+
+// apiErrorDiagnostics translates an error returned by the Account SDK into
+// diagnostics. Validation errors are reported against the attribute mapped
+// from the API field in fields, where one is given.
+func apiErrorDiagnostics(summary string, err error, fields map[string]path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		diags.AddError(
+			summary+": Timed Out",
+			fmt.Sprintf("%s\n\nThe Account API did not respond before the operation timed out. "+
+				"The timeout can be raised with the resource's timeouts block.", err),
+		)
+		return diags
+	}
+
+	if errors.Is(err, context.Canceled) {
+		diags.AddError(
+			summary+": Cancelled",
+			fmt.Sprintf("%s\n\nThe operation was cancelled before the Account API responded, "+
+				"so the change may or may not have been applied. Run terraform plan to check.", err),
+		)
+		return diags
+	}
+
+	if isNotFoundError(err) {
+		diags.AddError(
+			summary+": Not Found",
+			fmt.Sprintf("%s\n\nThe object may have been deleted outside of Terraform.", err),
+		)
+		return diags
+	}
+
+	statusCode, bodyErr := apiResponseError(err)
+
+	switch statusCode {
+	case http.StatusUnauthorized:
+		diags.AddError(
+			summary+": Authentication Failed",
+			fmt.Sprintf("%s\n\nThe API key was rejected by the Account API. "+
+				"Check the provider api_key attribute or the api_key of the selected config context.", err),
+		)
+	case http.StatusForbidden:
+		diags.AddError(
+			summary+": Permission Denied",
+			fmt.Sprintf("%s\n\nAPI key lacks the required Account API role. "+
+				"Reading requires account:read, and creating, updating or deleting requires account:write.", err),
+		)
+	case http.StatusTooManyRequests:
+		diags.AddError(
+			summary+": Rate Limited",
+			fmt.Sprintf("%s\n\nThe Account API is rate limiting this API key. "+
+				"Retry the operation, or reduce concurrency with terraform apply -parallelism=N.", err),
+		)
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		if bodyErr == nil || len(bodyErr.Errors) == 0 {
+			diags.AddError(summary+": Validation Failed", fmt.Sprint(err))
+			break
+		}
+
+		for _, item := range bodyErr.Errors {
+			detail := item.Detail
+			if len(item.Title) > 0 {
+				detail = fmt.Sprintf("%s: %s", item.Title, item.Detail)
+			}
+
+			if attributePath, ok := fieldPath(fields, item.Source); ok {
+				diags.AddAttributeError(attributePath, summary+": Validation Failed", detail)
+				continue
+			}
+
+			if len(item.Source) > 0 {
+				detail = fmt.Sprintf("%s (field: %s)", detail, item.Source)
+			}
+
+			diags.AddError(summary+": Validation Failed", detail)
+		}
+	default:
+		diags.AddError(summary, fmt.Sprint(err))
+	}
+
+	return diags
+}
+
+// isNotFoundError reports whether err is one of the SDK's typed not found errors.
+func isNotFoundError(err error) bool {
+	var applicationErr *accountservice.ApplicationNotFoundError
+	var clientErr *accountservice.ClientNotFoundError
+	var contactErr *accountservice.ContactNotFoundError
+	var invoiceErr *accountservice.InvoiceNotFoundError
+	var invoiceQueryErr *accountservice.InvoiceQueryNotFoundError
+
+	return errors.As(err, &applicationErr) ||
+		errors.As(err, &clientErr) ||
+		errors.As(err, &contactErr) ||
+		errors.As(err, &invoiceErr) ||
+		errors.As(err, &invoiceQueryErr)
+}
+
+// apiResponseError extracts the HTTP status code and decoded error body from an
+// SDK error. The body is wrapped in the generic APIResponseBodyData[T], which
+// embeds APIResponseBody and in turn APIResponseBodyError. As T varies by
+// call, the field is located by name rather than by type assertion.
+func apiResponseError(err error) (int, *connection.APIResponseBodyError) {
+	matches := statusCodePattern.FindStringSubmatch(err.Error())
+	if matches == nil {
+		return 0, nil
+	}
+
+	statusCode, _ := strconv.Atoi(matches[1])
+
+	wrapped := reflect.ValueOf(errors.Unwrap(err))
+	if wrapped.Kind() != reflect.Pointer || wrapped.IsNil() || wrapped.Elem().Kind() != reflect.Struct {
+		return statusCode, nil
+	}
+
+	field := wrapped.Elem().FieldByName("APIResponseBodyError")
+	if !field.IsValid() || !field.CanAddr() {
+		return statusCode, nil
+	}
+
+	bodyErr, ok := field.Addr().Interface().(*connection.APIResponseBodyError)
+	if !ok {
+		return statusCode, nil
+	}
+
+	return statusCode, bodyErr
+}
+
+// fieldPath maps an API error source such as "ip_ranges.1" to an attribute path.
+func fieldPath(fields map[string]path.Path, source string) (path.Path, bool) {
+	if len(source) == 0 {
+		return path.Empty(), false
+	}
+
+	if p, ok := fields[source]; ok {
+		return p, true
+	}
+
+	p, ok := fields[strings.SplitN(source, ".", 2)[0]]
+
+	return p, ok
+}
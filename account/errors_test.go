@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ans-group/sdk-go/pkg/connection"
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestApiErrorDiagnostics(t *testing.T) {
+	cases := []struct {
+		name       string
+		statusCode int
+		body       string
+		summary    string
+		detail     string
+		path       path.Path
+	}{
+		{
+			name:       "not found",
+			statusCode: http.StatusNotFound,
+			body:       `{}`,
+			summary:    "Error Updating API Application: Not Found",
+			detail:     "Application not found with ID [app-1]",
+		},
+		{
+			name:       "unauthorized",
+			statusCode: http.StatusUnauthorized,
+			body:       `{"message":"Unauthenticated."}`,
+			summary:    "Error Updating API Application: Authentication Failed",
+			detail:     "api_key",
+		},
+		{
+			name:       "forbidden",
+			statusCode: http.StatusForbidden,
+			body:       `{"errors":[{"title":"Forbidden","status":403}]}`,
+			summary:    "Error Updating API Application: Permission Denied",
+			detail:     "account:write",
+		},
+		{
+			name:       "rate limited",
+			statusCode: http.StatusTooManyRequests,
+			body:       `{}`,
+			summary:    "Error Updating API Application: Rate Limited",
+			detail:     "-parallelism",
+		},
+		{
+			name:       "validation on mapped field",
+			statusCode: http.StatusUnprocessableEntity,
+			body:       `{"errors":[{"title":"Validation Error","detail":"The name field is required.","status":422,"source":"name"}]}`,
+			summary:    "Error Updating API Application: Validation Failed",
+			detail:     "Validation Error: The name field is required.",
+			path:       path.Root("name"),
+		},
+		{
+			name:       "validation on unmapped field",
+			statusCode: http.StatusUnprocessableEntity,
+			body:       `{"errors":[{"title":"Validation Error","detail":"Invalid value.","status":422,"source":"created_by"}]}`,
+			summary:    "Error Updating API Application: Validation Failed",
+			detail:     "(field: created_by)",
+		},
+		{
+			name:       "server error",
+			statusCode: http.StatusInternalServerError,
+			body:       `{"message":"Server Error"}`,
+			summary:    "Error Updating API Application",
+			detail:     "unexpected status code (500)",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			service := testAccountServiceResponding(t, c.statusCode, c.body)
+
+			err := service.UpdateApplication("app-1", accountservice.UpdateApplicationRequest{Name: "test"})
+			if err == nil {
+				t.Fatal("expected error from UpdateApplication")
+			}
+
+			diags := apiErrorDiagnostics("Error Updating API Application", err, applicationFields)
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %d: %v", len(diags), diags)
+			}
+
+			d := diags[0]
+			if d.Severity() != diag.SeverityError {
+				t.Errorf("expected error severity, got %s", d.Severity())
+			}
+
+			if d.Summary() != c.summary {
+				t.Errorf("expected summary %q, got %q", c.summary, d.Summary())
+			}
+
+			if !strings.Contains(d.Detail(), c.detail) {
+				t.Errorf("expected detail to contain %q, got %q", c.detail, d.Detail())
+			}
+
+			withPath, ok := d.(diag.DiagnosticWithPath)
+			if len(c.path.Steps()) == 0 {
+				if ok {
+					t.Errorf("expected no attribute path, got %s", withPath.Path())
+				}
+				return
+			}
+
+			if !ok || !withPath.Path().Equal(c.path) {
+				t.Errorf("expected attribute path %s, got %v", c.path, d)
+			}
+		})
+	}
+}
+
+// TestApiResponseError pins the errors returned by sdk-go v1.20.4, which
+// apiResponseError parses. A failure here after upgrading the SDK means the
+// format of its errors has changed.
+func TestApiResponseError(t *testing.T) {
+	cases := []struct {
+		name       string
+		statusCode int
+		body       string
+		err        string
+		wantStatus int
+		wantBody   *connection.APIResponseBodyError
+	}{
+		{
+			name:       "unauthorized",
+			statusCode: http.StatusUnauthorized,
+			body:       `{"message":"Unauthenticated."}`,
+			err:        `unexpected status code (401): message="Unauthenticated."`,
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   &connection.APIResponseBodyError{Message: "Unauthenticated."},
+		},
+		{
+			name:       "forbidden",
+			statusCode: http.StatusForbidden,
+			body:       `{"errors":[{"title":"Forbidden","detail":"Insufficient permissions","status":403}]}`,
+			err:        `unexpected status code (403): title="Forbidden", detail="Insufficient permissions", status="403", source=""`,
+			wantStatus: http.StatusForbidden,
+			wantBody: &connection.APIResponseBodyError{
+				Errors: []connection.APIResponseBodyErrorItem{
+					{Title: "Forbidden", Detail: "Insufficient permissions", Status: http.StatusForbidden},
+				},
+			},
+		},
+		{
+			// The SDK replaces not found responses with its typed error, so
+			// no status code is parsed from them.
+			name:       "not found",
+			statusCode: http.StatusNotFound,
+			body:       `{"errors":[{"title":"Not found","detail":"Application not found","status":404}]}`,
+			err:        `Application not found with ID [app-1]`,
+		},
+		{
+			name:       "validation",
+			statusCode: http.StatusUnprocessableEntity,
+			body:       `{"errors":[{"title":"Validation Error","detail":"The name field is required.","status":422,"source":"name"}]}`,
+			err:        `unexpected status code (422): title="Validation Error", detail="The name field is required.", status="422", source="name"`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody: &connection.APIResponseBodyError{
+				Errors: []connection.APIResponseBodyErrorItem{
+					{Title: "Validation Error", Detail: "The name field is required.", Status: http.StatusUnprocessableEntity, Source: "name"},
+				},
+			},
+		},
+		{
+			name:       "rate limited",
+			statusCode: http.StatusTooManyRequests,
+			body:       `{"message":"Too Many Attempts."}`,
+			err:        `unexpected status code (429): message="Too Many Attempts."`,
+			wantStatus: http.StatusTooManyRequests,
+			wantBody:   &connection.APIResponseBodyError{Message: "Too Many Attempts."},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			service := testAccountServiceResponding(t, c.statusCode, c.body)
+
+			err := service.UpdateApplication("app-1", accountservice.UpdateApplicationRequest{Name: "test"})
+			if err == nil {
+				t.Fatal("expected error from UpdateApplication")
+			}
+
+			if err.Error() != c.err {
+				t.Errorf("expected error %q, got %q", c.err, err.Error())
+			}
+
+			statusCode, bodyErr := apiResponseError(err)
+			if statusCode != c.wantStatus {
+				t.Errorf("expected status code %d, got %d", c.wantStatus, statusCode)
+			}
+
+			if !reflect.DeepEqual(bodyErr, c.wantBody) {
+				t.Errorf("expected body %+v, got %+v", c.wantBody, bodyErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &IPAllowedFunction{}
+
+func NewIPAllowedFunction() function.Function {
+	return &IPAllowedFunction{}
+}
+
+// IPAllowedFunction defines the function implementation.
+type IPAllowedFunction struct{}
+
+func (f *IPAllowedFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ip_allowed"
+}
+
+func (f *IPAllowedFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check whether an IP address may use a restricted key",
+		Description: "Returns true when an IP address may use an application key with the given restriction. " +
+			"An allowlist permits only addresses within the ranges, and a denylist permits any address outside them. " +
+			"A restriction without ranges permits every address.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ip",
+				Description: "IP address to check",
+			},
+			function.StringParameter{
+				Name:        "type",
+				Description: "Restriction type, allowlist or denylist",
+			},
+			function.ListParameter{
+				Name:        "ranges",
+				ElementType: types.StringType,
+				Description: "IP addresses and CIDR ranges of the restriction",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IPAllowedFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ip, restrictionType string
+	var ranges []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ip, &restrictionType, &ranges))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := normalizeRanges(ranges); err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	allowed, err := ipAllowed(ip, restrictionType, ranges)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, allowed))
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &MergeScopesFunction{}
+
+func NewMergeScopesFunction() function.Function {
+	return &MergeScopesFunction{}
+}
+
+// MergeScopesFunction defines the function implementation.
+type MergeScopesFunction struct{}
+
+func (f *MergeScopesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "merge_scopes"
+}
+
+func (f *MergeScopesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	scopeType := types.ObjectType{AttrTypes: ApplicationServiceScope{}.attributeTypes()}
+
+	resp.Definition = function.Definition{
+		Summary: "Merge two lists of service scopes",
+		Description: "Merges two lists of service scopes, as used by the services attribute of account_application_services, " +
+			"into one entry per service holding the roles from both lists. The result is sorted by service and role, " +
+			"matching the form the Account API returns.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "a",
+				ElementType: scopeType,
+				Description: "First list of service scopes",
+			},
+			function.ListParameter{
+				Name:        "b",
+				ElementType: scopeType,
+				Description: "Second list of service scopes",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: scopeType,
+		},
+	}
+}
+
+func (f *MergeScopesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b []ApplicationServiceScope
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &a, &b))
+
+	if resp.Error != nil {
+		return
+	}
+
+	merged := expandApplicationScope(ctx, append(a, b...))
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, readApplicationScope(ctx, merged)))
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NormalizeRangesFunction{}
+
+func NewNormalizeRangesFunction() function.Function {
+	return &NormalizeRangesFunction{}
+}
+
+// NormalizeRangesFunction defines the function implementation.
+type NormalizeRangesFunction struct{}
+
+func (f *NormalizeRangesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_ranges"
+}
+
+func (f *NormalizeRangesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a list of IP ranges",
+		Description: "Returns IP addresses and CIDR ranges in canonical form, sorted and without duplicates. " +
+			"Networks are masked to their network address, so 10.0.0.1/8 becomes 10.0.0.0/8.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "ranges",
+				ElementType: types.StringType,
+				Description: "IP addresses and CIDR ranges",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *NormalizeRangesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ranges []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ranges))
+
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeRanges(ranges)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, normalized))
+}
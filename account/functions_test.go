@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testRunFunction runs f with args, returning its result and error.
+func testRunFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	ctx := context.Background()
+
+	var defResp function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &defResp)
+
+	result, funcErr := defResp.Definition.Return.NewResultData(ctx)
+	if funcErr != nil {
+		t.Fatal(funcErr)
+	}
+
+	resp := function.RunResponse{
+		Result: result,
+	}
+
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func testStringList(values ...string) types.List {
+	elements := make([]attr.Value, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func testScopeList(scopes map[string][]string) types.List {
+	elements := make([]attr.Value, 0, len(scopes))
+	for name, roles := range scopes {
+		elements = append(elements, types.ObjectValueMust(ApplicationServiceScope{}.attributeTypes(), map[string]attr.Value{
+			"name":  types.StringValue(name),
+			"roles": testStringList(roles...),
+		}))
+	}
+
+	return types.ListValueMust(types.ObjectType{AttrTypes: ApplicationServiceScope{}.attributeTypes()}, elements)
+}
+
+func TestNormalizeRanges(t *testing.T) {
+	cases := map[string]struct {
+		ranges   []string
+		expected []string
+		err      bool
+	}{
+		"sorts and dedupes": {
+			ranges:   []string{"2.2.2.2", "1.1.1.1", "2.2.2.2/32"},
+			expected: []string{"1.1.1.1", "2.2.2.2"},
+		},
+		"masks networks": {
+			ranges:   []string{"10.1.2.3/8", " 192.168.0.1/24 "},
+			expected: []string{"10.0.0.0/8", "192.168.0.0/24"},
+		},
+		"ipv6": {
+			ranges:   []string{"2001:db8::1/32", "::ffff:1.1.1.1"},
+			expected: []string{"1.1.1.1", "2001:db8::/32"},
+		},
+		"invalid": {
+			ranges: []string{"not-an-ip"},
+			err:    true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			normalized, err := normalizeRanges(c.ranges)
+			if c.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(normalized, c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, normalized)
+			}
+		})
+	}
+}
+
+func TestIPAllowed(t *testing.T) {
+	cases := map[string]struct {
+		ip              string
+		restrictionType string
+		ranges          []string
+		expected        bool
+		err             bool
+	}{
+		"allowlist match":     {ip: "10.0.0.5", restrictionType: "allowlist", ranges: []string{"10.0.0.0/24"}, expected: true},
+		"allowlist miss":      {ip: "10.0.1.5", restrictionType: "allowlist", ranges: []string{"10.0.0.0/24"}, expected: false},
+		"allowlist address":   {ip: "1.1.1.1", restrictionType: "allowlist", ranges: []string{"1.1.1.1"}, expected: true},
+		"denylist match":      {ip: "10.0.0.5", restrictionType: "denylist", ranges: []string{"10.0.0.0/24"}, expected: false},
+		"denylist miss":       {ip: "10.0.1.5", restrictionType: "denylist", ranges: []string{"10.0.0.0/24"}, expected: true},
+		"no ranges":           {ip: "10.0.1.5", restrictionType: "allowlist", expected: true},
+		"invalid ip":          {ip: "10.0.1", restrictionType: "allowlist", err: true},
+		"invalid type":        {ip: "10.0.1.5", restrictionType: "blocklist", err: true},
+		"invalid range":       {ip: "10.0.1.5", restrictionType: "allowlist", ranges: []string{"10.0.0.0/33"}, err: true},
+		"mapped ipv4 address": {ip: "::ffff:10.0.0.5", restrictionType: "allowlist", ranges: []string{"10.0.0.0/24"}, expected: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowed, err := ipAllowed(c.ip, c.restrictionType, c.ranges)
+			if c.err {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if allowed != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, allowed)
+			}
+		})
+	}
+}
+
+func TestRangesEquivalent(t *testing.T) {
+	if !rangesEquivalent([]string{"2.2.2.2", "1.1.1.0/24"}, []string{"1.1.1.0/24", "2.2.2.2/32"}) {
+		t.Error("expected reordered ranges to be equivalent")
+	}
+
+	if rangesEquivalent([]string{"1.1.1.1"}, []string{"1.1.1.2"}) {
+		t.Error("expected different ranges not to be equivalent")
+	}
+}
+
+func TestMergeScopesFunction(t *testing.T) {
+	result, err := testRunFunction(t, NewMergeScopesFunction(),
+		testScopeList(map[string][]string{"ecloud": {"write", "read"}}),
+		testScopeList(map[string][]string{"ecloud": {"read"}, "account": {"read"}}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var scopes []ApplicationServiceScope
+	if diags := result.(types.List).ElementsAs(context.Background(), &scopes, false); diags.HasError() {
+		t.Fatalf("unexpected error reading result: %v", diags)
+	}
+
+	got := make(map[string][]string)
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = scope.Name.ValueString()
+		got[scope.Name.ValueString()] = expandArray(context.Background(), scope.Roles)
+	}
+
+	if !reflect.DeepEqual(names, []string{"account", "ecloud"}) {
+		t.Errorf("unexpected services %v", names)
+	}
+
+	if !reflect.DeepEqual(got["ecloud"], []string{"read", "write"}) {
+		t.Errorf("unexpected ecloud roles %v", got["ecloud"])
+	}
+}
+
+func TestNormalizeRangesFunction(t *testing.T) {
+	result, err := testRunFunction(t, NewNormalizeRangesFunction(), testStringList("10.0.0.1/8", "1.1.1.1", "1.1.1.1/32"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !result.Equal(testStringList("1.1.1.1", "10.0.0.0/8")) {
+		t.Errorf("unexpected result %s", result)
+	}
+
+	_, err = testRunFunction(t, NewNormalizeRangesFunction(), testStringList("1.1.1"))
+	if err == nil || err.FunctionArgument == nil || *err.FunctionArgument != 0 {
+		t.Errorf("expected argument error, got %v", err)
+	}
+}
+
+func TestIPAllowedFunction(t *testing.T) {
+	result, err := testRunFunction(t, NewIPAllowedFunction(),
+		types.StringValue("10.0.0.5"),
+		types.StringValue("denylist"),
+		testStringList("10.0.0.0/24"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !result.Equal(types.BoolValue(false)) {
+		t.Errorf("unexpected result %s", result)
+	}
+}
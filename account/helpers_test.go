@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ans-group/sdk-go/pkg/connection"
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testAccountServiceResponding returns an AccountService which answers every
+// request with statusCode and body.
+func testAccountServiceResponding(t *testing.T, statusCode int, body string) accountservice.AccountService {
+	return testAccountService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+		_, _ = w.Write([]byte(body))
+	})
+}
+
+// testAccountService returns an AccountService backed by handler rather than the live API.
+func testAccountService(t *testing.T, handler http.HandlerFunc) accountservice.AccountService {
+	return testAccountClient(t, handler).Service(context.Background())
+}
+
+// testAccountClient returns an accountClient whose requests are served by handler.
+func testAccountClient(t *testing.T, handler http.HandlerFunc) *accountClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	conn := connection.NewAPIKeyCredentialsAPIConnection("test")
+	conn.APIScheme = "http"
+	conn.APIURI = strings.TrimPrefix(server.URL, "http://")
+
+	return newAccountClient(conn)
+}
+
+// testResourceState builds state for r from the model d, or null state when d
+// is nil.
+func testResourceState(t *testing.T, r resource.Resource, d interface{}) tfsdk.State {
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if d == nil {
+		return state
+	}
+
+	if diags := state.Set(ctx, d); diags.HasError() {
+		t.Fatalf("unexpected error building state: %v", diags)
+	}
+
+	return state
+}
+
+// testResourcePlan builds a plan for r from the model d.
+func testResourcePlan(t *testing.T, r resource.Resource, d interface{}) tfsdk.Plan {
+	state := testResourceState(t, r, d)
+
+	return tfsdk.Plan{
+		Schema: state.Schema,
+		Raw:    state.Raw,
+	}
+}
+
+// testModel fills the attributes of the resource model d a test leaves unset.
+// A null timeouts block is set, and other null fields take the value given in
+// defaults for their attribute name.
+func testModel[T any](d T, defaults map[string]attr.Value) *T {
+	v := reflect.ValueOf(&d).Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if !field.IsZero() {
+			continue
+		}
+
+		if _, ok := field.Interface().(timeouts.Value); ok {
+			field.Set(reflect.ValueOf(nullTimeouts()))
+			continue
+		}
+
+		if value, ok := defaults[v.Type().Field(i).Tag.Get("tfsdk")]; ok {
+			field.Set(reflect.ValueOf(value))
+		}
+	}
+
+	return &d
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	// onDestroyRestore puts back the settings captured when the resource was
+	// created or imported.
+	onDestroyRestore = "restore"
+	// onDestroyClear removes the settings from the application.
+	onDestroyClear = "clear"
+	// onDestroyAbandon leaves the settings on the application unchanged.
+	onDestroyAbandon = "abandon"
+)
+
+// originalPrivateKey is the private state key holding the settings an
+// application had before the resource managed them.
+const originalPrivateKey = "original"
+
+// onDestroyAttribute returns the on_destroy attribute of a resource managing
+// the application settings named by settings.
+func onDestroyAttribute(settings string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(onDestroyClear),
+		Validators: []validator.String{
+			stringvalidator.OneOf(onDestroyRestore, onDestroyClear, onDestroyAbandon),
+		},
+		Description: fmt.Sprintf("What happens to the application's %s when the resource is destroyed: 'restore' "+
+			"those it had when the resource was created or imported, 'clear' them, or 'abandon' them unchanged. Defaults to clear", settings),
+	}
+}
+
+type privateStateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// setOriginal records original in private state as the application's settings
+// before the resource managed them.
+func setOriginal(ctx context.Context, private privateStateSetter, original any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, err := json.Marshal(original)
+	if err != nil {
+		diags.AddError("Error Encoding Private State", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, originalPrivateKey, value)
+}
+
+// getOriginal decodes the settings recorded by setOriginal into original,
+// reporting whether any were recorded.
+func getOriginal(ctx context.Context, private privateStateGetter, original any) (bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, originalPrivateKey)
+	if diags.HasError() || len(value) == 0 {
+		return false, diags
+	}
+
+	if err := json.Unmarshal(value, original); err != nil {
+		diags.AddError("Error Decoding Private State", err.Error())
+		return false, diags
+	}
+
+	return true, diags
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testPrivateState is an in-memory stand-in for resource private state.
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func TestOriginalPrivateState(t *testing.T) {
+	ctx := context.Background()
+	private := testPrivateState{}
+
+	var missing accountservice.ApplicationRestriction
+	if found, diags := getOriginal(ctx, private, &missing); found || diags.HasError() {
+		t.Fatalf("expected nothing recorded, got found=%t diags=%+v", found, diags)
+	}
+
+	original := accountservice.ApplicationServiceMapping{
+		Scopes: []accountservice.ApplicationServiceScope{{Service: "ecloud", Roles: []string{"read"}}},
+	}
+
+	if diags := setOriginal(ctx, private, original); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+
+	var recorded accountservice.ApplicationServiceMapping
+
+	found, diags := getOriginal(ctx, private, &recorded)
+	if !found || diags.HasError() {
+		t.Fatalf("expected original to be recorded, got found=%t diags=%+v", found, diags)
+	}
+
+	if !reflect.DeepEqual(recorded, original) {
+		t.Errorf("expected %+v, got %+v", original, recorded)
+	}
+}
+
+func TestOnDestroyAttributeValidators(t *testing.T) {
+	validators := onDestroyAttribute("services").StringValidators()
+
+	cases := map[string]struct {
+		value types.String
+		err   bool
+	}{
+		"valid":   {value: types.StringValue(onDestroyRestore)},
+		"null":    {value: types.StringNull()},
+		"unknown": {value: types.StringUnknown()},
+		"invalid": {value: types.StringValue("delete"), err: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			for _, v := range validators {
+				v.ValidateString(context.Background(), validator.StringRequest{
+					Path:        path.Root("on_destroy"),
+					ConfigValue: c.value,
+				}, resp)
+			}
+
+			if resp.Diagnostics.HasError() != c.err {
+				t.Errorf("expected error=%t, got %+v", c.err, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// testRecordingHandler records the path and body of each PUT request.
+func testRecordingHandler(t *testing.T, puts map[string]json.RawMessage) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var body json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+
+		puts[r.URL.Path] = body
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestRestoreApplicationServices(t *testing.T) {
+	original := accountservice.ApplicationServiceMapping{
+		Scopes: []accountservice.ApplicationServiceScope{{Service: "ecloud", Roles: []string{"read"}}},
+	}
+
+	cases := map[string]struct {
+		original *accountservice.ApplicationServiceMapping
+		restored bool
+		warning  bool
+	}{
+		"recorded":      {original: &original, restored: true},
+		"recorded none": {original: &accountservice.ApplicationServiceMapping{}},
+		"not recorded":  {warning: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			private := testPrivateState{}
+
+			if c.original != nil {
+				if diags := setOriginal(ctx, private, c.original); diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %+v", diags)
+				}
+			}
+
+			puts := map[string]json.RawMessage{}
+			service := testAccountService(t, testRecordingHandler(t, puts))
+
+			restored, diags := restoreApplicationServices(ctx, service, "app-1", private)
+
+			if diags.HasError() || (diags.WarningsCount() > 0) != c.warning {
+				t.Fatalf("expected warning=%t, got %+v", c.warning, diags)
+			}
+
+			if restored != c.restored {
+				t.Fatalf("expected restored=%t", c.restored)
+			}
+
+			body, ok := puts["/account/v1/applications/app-1/services"]
+			if ok != c.restored {
+				t.Fatalf("expected services to be set=%t, got %+v", c.restored, puts)
+			}
+
+			if ok && !strings.Contains(string(body), `"service":"ecloud"`) {
+				t.Errorf("expected the original services, got %s", body)
+			}
+		})
+	}
+}
+
+func TestRestoreApplicationRestrictions(t *testing.T) {
+	original := accountservice.ApplicationRestriction{
+		IPRestrictionType: "allowlist",
+		IPRanges:          []string{"192.0.2.0/24"},
+	}
+
+	cases := map[string]struct {
+		original *accountservice.ApplicationRestriction
+		restored bool
+		warning  bool
+	}{
+		"recorded":      {original: &original, restored: true},
+		"recorded none": {original: &accountservice.ApplicationRestriction{}},
+		"not recorded":  {warning: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			private := testPrivateState{}
+
+			if c.original != nil {
+				if diags := setOriginal(ctx, private, c.original); diags.HasError() {
+					t.Fatalf("unexpected diagnostics: %+v", diags)
+				}
+			}
+
+			puts := map[string]json.RawMessage{}
+			service := testAccountService(t, testRecordingHandler(t, puts))
+
+			restored, diags := restoreApplicationRestrictions(ctx, service, "app-1", private)
+
+			if diags.HasError() || (diags.WarningsCount() > 0) != c.warning {
+				t.Fatalf("expected warning=%t, got %+v", c.warning, diags)
+			}
+
+			if restored != c.restored {
+				t.Fatalf("expected restored=%t", c.restored)
+			}
+
+			body, ok := puts["/account/v1/applications/app-1/ip-restrictions"]
+			if ok != c.restored {
+				t.Fatalf("expected restrictions to be set=%t, got %+v", c.restored, puts)
+			}
+
+			if ok && !strings.Contains(string(body), `"192.0.2.0/24"`) {
+				t.Errorf("expected the original ranges, got %s", body)
+			}
+		})
+	}
+}
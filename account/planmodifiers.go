@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nullWhenKeyWriteOnly plans a null key when key_wo_version is configured, so
+// the key is never written to state.
+func nullWhenKeyWriteOnly() planmodifier.String {
+	return keyWriteOnlyModifier{}
+}
+
+type keyWriteOnlyModifier struct{}
+
+func (m keyWriteOnlyModifier) Description(_ context.Context) string {
+	return "The key is not stored in state when key_wo_version is set."
+}
+
+func (m keyWriteOnlyModifier) MarkdownDescription(_ context.Context) string {
+	return "The key is not stored in state when `key_wo_version` is set."
+}
+
+func (m keyWriteOnlyModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var version types.Int64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key_wo_version"), &version)...)
+
+	if resp.Diagnostics.HasError() || version.IsNull() {
+		return
+	}
+
+	resp.PlanValue = types.StringNull()
+}
+
+// keyWOVersionChanged requires replacement when key_wo_version changes from one
+// version to another. Setting or removing it keeps the existing application.
+func keyWOVersionChanged(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = keyWOVersionReplaces(req.StateValue, req.PlanValue)
+}
+
+// keyWOVersionReplaces reports whether planning key_wo_version as plan, with
+// state as its prior value, replaces the application.
+func keyWOVersionReplaces(state types.Int64, plan types.Int64) bool {
+	return !state.IsNull() && !plan.IsNull() && !plan.Equal(state)
+}
+
+// contactMethodChanged requires replacement when contact_method changes. The
+// API never returns contact_method, so an imported invoice query has none in
+// state and adopts the configured value without being raised again.
+func contactMethodChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull() && !req.PlanValue.Equal(req.StateValue)
+}
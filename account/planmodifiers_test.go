@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testApplicationState builds account_application state from d.
+func testApplicationState(t *testing.T, d AccountApplicationModel) tfsdk.State {
+	return testResourceState(t, NewAccountApplication(), testModel(d, nil))
+}
+
+// testApplicationConfig builds account_application configuration from d.
+func testApplicationConfig(t *testing.T, d AccountApplicationModel) tfsdk.Config {
+	state := testApplicationState(t, d)
+
+	return tfsdk.Config{
+		Schema: state.Schema,
+		Raw:    state.Raw,
+	}
+}
+
+func TestNullWhenKeyWriteOnly(t *testing.T) {
+	cases := map[string]struct {
+		version  types.Int64
+		expected types.String
+	}{
+		"unset":   {version: types.Int64Null(), expected: types.StringValue("abcdef")},
+		"version": {version: types.Int64Value(1), expected: types.StringNull()},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				Config:    testApplicationConfig(t, AccountApplicationModel{Name: types.StringValue("test"), KeyWOVersion: c.version}),
+				PlanValue: types.StringValue("abcdef"),
+			}
+			resp := planmodifier.StringResponse{PlanValue: req.PlanValue}
+
+			nullWhenKeyWriteOnly().PlanModifyString(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if !resp.PlanValue.Equal(c.expected) {
+				t.Errorf("expected %s, got %s", c.expected, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestKeyWOVersionReplaces(t *testing.T) {
+	cases := map[string]struct {
+		state    types.Int64
+		plan     types.Int64
+		expected bool
+	}{
+		"unset":     {state: types.Int64Null(), plan: types.Int64Null()},
+		"set":       {state: types.Int64Null(), plan: types.Int64Value(1)},
+		"removed":   {state: types.Int64Value(1), plan: types.Int64Null()},
+		"unchanged": {state: types.Int64Value(1), plan: types.Int64Value(1)},
+		"changed":   {state: types.Int64Value(1), plan: types.Int64Value(2), expected: true},
+		"unknown":   {state: types.Int64Value(1), plan: types.Int64Unknown(), expected: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			if actual := keyWOVersionReplaces(c.state, c.plan); actual != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestContactMethodChanged(t *testing.T) {
+	cases := map[string]struct {
+		state    types.String
+		plan     types.String
+		expected bool
+	}{
+		"imported":  {state: types.StringNull(), plan: types.StringValue("email")},
+		"unchanged": {state: types.StringValue("email"), plan: types.StringValue("email")},
+		"changed":   {state: types.StringValue("email"), plan: types.StringValue("phone"), expected: true},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := planmodifier.StringRequest{StateValue: c.state, PlanValue: c.plan}
+			resp := stringplanmodifier.RequiresReplaceIfFuncResponse{}
+
+			contactMethodChanged(context.Background(), req, &resp)
+
+			if resp.RequiresReplace != c.expected {
+				t.Errorf("expected %t, got %t", c.expected, resp.RequiresReplace)
+			}
+		})
+	}
+}
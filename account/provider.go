@@ -6,13 +6,18 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"terraform-provider-account/pkg/logger"
+	"time"
 
-	"github.com/ans-group/sdk-go/pkg/client"
 	"github.com/ans-group/sdk-go/pkg/config"
 	"github.com/ans-group/sdk-go/pkg/connection"
 	"github.com/ans-group/sdk-go/pkg/logging"
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -23,8 +28,16 @@ import (
 
 const userAgent = "terraform-provider-account"
 
+// defaultTimeout applies to resource operations without a configured timeout.
+const defaultTimeout = 5 * time.Minute
+
+// preflightApplicationID is the nil UUID, which is never assigned to an application.
+const preflightApplicationID = "00000000-0000-0000-0000-000000000000"
+
 var (
-	_ provider.Provider = &accountProvider{}
+	_ provider.Provider                       = &accountProvider{}
+	_ provider.ProviderWithEphemeralResources = &accountProvider{}
+	_ provider.ProviderWithFunctions          = &accountProvider{}
 )
 
 func New(version string) func() provider.Provider {
@@ -40,8 +53,11 @@ type accountProvider struct {
 }
 
 type accountProviderModel struct {
-	Context types.String `tfsdk:"context"`
-	APIKey  types.String `tfsdk:"api_key"`
+	Context                    types.String  `tfsdk:"context"`
+	APIKey                     types.String  `tfsdk:"api_key"`
+	Preflight                  types.Bool    `tfsdk:"preflight"`
+	Presets                    []PresetModel `tfsdk:"preset"`
+	ErrorOnPrivilegeEscalation types.Bool    `tfsdk:"error_on_privilege_escalation"`
 }
 
 func (p *accountProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -61,6 +77,47 @@ func (p *accountProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Sensitive:   true,
 				Description: "API token to authenticate with UKFast APIs. See https://developers.ukfast.io for more details",
 			},
+			"preflight": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Verify during configuration that the API key is valid and has write access to the Account API",
+			},
+			"error_on_privilege_escalation": schema.BoolAttribute{
+				Optional: true,
+				Description: "Fail plans which grant an application roles it does not already have, unless the " +
+					"account_application_services or account_application_service resource sets acknowledge_privilege_escalation. " +
+					"Defaults to false",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"preset": schema.ListNestedBlock{
+				Description: "Defines a custom role preset for use with account_application_services",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "Name of the preset",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"service": schema.ListNestedBlock{
+							Description: "Defines service access granted by the preset",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Required:    true,
+										Description: "Name of service",
+									},
+									"roles": schema.ListAttribute{
+										ElementType: types.StringType,
+										Required:    true,
+										Description: "List of service roles",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		Description: "Official ANS Account Terraform provider, allowing for manipulation of Glass Account environments",
 	}
@@ -112,32 +169,98 @@ func (p *accountProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
-	client := client.NewClient(conn).AccountService()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Create Account API Client",
-			"An unexpected error occurred when creating the Account API client. "+
-				"If the error is not clear, please contact the provider developers.\n\n"+
-				"Account Client Error: "+err.Error(),
-		)
+	client := newAccountClient(conn)
+
+	presets, diags := expandPresets(ctx, configuration.Presets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	client.presets = presets
+	client.errorOnPrivilegeEscalation = configuration.ErrorOnPrivilegeEscalation.ValueBool()
+
+	if configuration.Preflight.ValueBool() {
+		tflog.Info(ctx, "Running Account API preflight check")
+		resp.Diagnostics.Append(preflightCheck(client.Service(ctx))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+}
+
+// preflightCheck verifies the API key can read from and write to the Account API.
+// Write access is probed by updating an application which cannot exist, so that
+// nothing is changed. Only a 401 or 403 answer fails the check: 404, 400 and 422
+// mean the request got past authentication, whichever order the API checks
+// permissions, existence and validation in. Other failures leave write access
+// unverified and are reported as a warning.
+func preflightCheck(service accountservice.AccountService) diag.Diagnostics {
+	_, err := service.GetApplicationsPaginated(connection.APIRequestParameters{
+		Pagination: connection.APIRequestPagination{PerPage: 1},
+	})
+	if err != nil {
+		return apiErrorDiagnostics("Account API Preflight Check Failed", err, nil)
+	}
+
+	err = service.UpdateApplication(preflightApplicationID, accountservice.UpdateApplicationRequest{
+		Name: "terraform-provider-account-preflight",
+	})
+	if err == nil || isNotFoundError(err) {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+
+	statusCode, _ := apiResponseError(err)
+
+	switch statusCode {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return nil
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return apiErrorDiagnostics("Account API Preflight Check Failed", err, nil)
+	}
+
+	diags.AddWarning("Account API Preflight Check Inconclusive",
+		fmt.Sprintf("Write access to the Account API could not be verified: %s", err))
+
+	return diags
 }
 
-func getConnection() (connection.Connection, error) {
+func getConnection() (*connection.APIConnection, error) {
 	connFactory := connection.NewDefaultConnectionFactory(
 		connection.WithDefaultConnectionUserAgent(userAgent),
 	)
 
-	return connFactory.NewConnection()
+	conn, err := connFactory.NewConnection()
+	if err != nil {
+		return nil, err
+	}
+
+	apiConn, ok := conn.(*connection.APIConnection)
+	if !ok {
+		return nil, fmt.Errorf("unexpected connection type %T", conn)
+	}
+
+	return apiConn, nil
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *accountProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewApplicationPolicyDocumentDataSource,
+		NewClientDataSource,
+		NewContactDataSource,
+		NewContactsDataSource,
+		NewCreditsDataSource,
+		NewDetailsDataSource,
+		NewInvoiceDataSource,
+		NewInvoicesDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
@@ -146,5 +269,24 @@ func (p *accountProvider) Resources(_ context.Context) []func() resource.Resourc
 		NewAccountApplication,
 		NewApplicationIPRestriction,
 		NewApplicationServiceMapping,
+		NewApplicationServiceMember,
+		NewInvoiceQuery,
+		NewResellerClient,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *accountProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewApplicationKeyEphemeral,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *accountProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewIPAllowedFunction,
+		NewMergeScopesFunction,
+		NewNormalizeRangesFunction,
 	}
 }
@@ -6,6 +6,7 @@ import (
 
 	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -52,6 +53,62 @@ func TestAccApplicationRestriction_basic(t *testing.T) {
 
 }
 
+func TestAccApplicationRestriction_retarget(t *testing.T) {
+	resourceName := "account_application_restriction.test-application-restriction"
+
+	service := AccTestingClient{}
+	service.Configure()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             service.testAccCheckApplicationRestrictionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccResourceApplicationRestrictionConfig_retarget("first"),
+				Check: resource.ComposeTestCheckFunc(
+					service.testAccCheckApplicationRestrictionExists(t, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "account_application.first", "id"),
+				),
+			},
+			{
+				Config: providerConfig + testAccResourceApplicationRestrictionConfig_retarget("second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					service.testAccCheckApplicationRestrictionExists(t, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "account_application.second", "id"),
+					service.testAccCheckApplicationRestrictionsEmpty(t, "account_application.first"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckApplicationRestrictionsEmpty checks that application n has no IP ranges restricted.
+func (r *AccTestingClient) testAccCheckApplicationRestrictionsEmpty(t *testing.T, n string) resource.TestCheckFunc {
+	service := r.client
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			t.Fatalf("Application not found: %s", n)
+		}
+
+		restrictions, err := service.GetApplicationRestrictions(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(restrictions.IPRanges) > 0 {
+			return fmt.Errorf("Application with ID [%s] still has restrictions %+v", rs.Primary.ID, restrictions.IPRanges)
+		}
+
+		return nil
+	}
+}
+
 func (r *AccTestingClient) testAccCheckApplicationRestrictionExists(t *testing.T, n string) resource.TestCheckFunc {
 	service := r.client
 	return func(s *terraform.State) error {
@@ -114,3 +171,22 @@ func testAccResourceApplicationRestrictionConfig_basic(restrictionType string, i
 		`, restrictionType, ipRange,
 	)
 }
+
+func testAccResourceApplicationRestrictionConfig_retarget(target string) string {
+	return fmt.Sprintf(`
+		resource "account_application" "first"{
+			name = "tftest-application-restriction-first"
+		}
+
+		resource "account_application" "second"{
+			name = "tftest-application-restriction-second"
+		}
+
+		resource "account_application_restriction" "test-application-restriction"{
+			application_id = account_application.%s.id
+			type = "allowlist"
+			ranges = ["1.1.1.1"]
+		}
+		`, target,
+	)
+}
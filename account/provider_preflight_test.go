@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestPreflightCheck(t *testing.T) {
+	cases := []struct {
+		name        string
+		getStatus   int
+		patchStatus int
+		summary     string
+		warning     bool
+	}{
+		{
+			name:        "read write key",
+			getStatus:   http.StatusOK,
+			patchStatus: http.StatusNotFound,
+		},
+		{
+			name:        "invalid key",
+			getStatus:   http.StatusUnauthorized,
+			patchStatus: http.StatusUnauthorized,
+			summary:     "Account API Preflight Check Failed: Authentication Failed",
+		},
+		{
+			name:        "read only key",
+			getStatus:   http.StatusOK,
+			patchStatus: http.StatusForbidden,
+			summary:     "Account API Preflight Check Failed: Permission Denied",
+		},
+		{
+			name:        "unauthorised write",
+			getStatus:   http.StatusOK,
+			patchStatus: http.StatusUnauthorized,
+			summary:     "Account API Preflight Check Failed: Authentication Failed",
+		},
+		{
+			name:        "validated before existence",
+			getStatus:   http.StatusOK,
+			patchStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "unprocessable before existence",
+			getStatus:   http.StatusOK,
+			patchStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:        "server error",
+			getStatus:   http.StatusOK,
+			patchStatus: http.StatusInternalServerError,
+			summary:     "Account API Preflight Check Inconclusive",
+			warning:     true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			service := testAccountService(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPatch {
+					w.WriteHeader(c.patchStatus)
+					_, _ = w.Write([]byte(`{}`))
+					return
+				}
+
+				w.WriteHeader(c.getStatus)
+				_, _ = w.Write([]byte(`{"data":[],"meta":{"pagination":{"total_pages":1}}}`))
+			})
+
+			diags := preflightCheck(service)
+
+			if len(c.summary) == 0 {
+				if len(diags) > 0 {
+					t.Fatalf("expected no diagnostics, got %v", diags)
+				}
+				return
+			}
+
+			if len(diags) != 1 || diags[0].Summary() != c.summary {
+				t.Fatalf("expected diagnostic %q, got %v", c.summary, diags)
+			}
+
+			if diags.HasError() == c.warning {
+				t.Fatalf("expected warning=%t, got %v", c.warning, diags)
+			}
+		})
+	}
+}
@@ -4,12 +4,14 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	"github.com/ans-group/sdk-go/pkg/client"
 	"github.com/ans-group/sdk-go/pkg/connection"
 	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -51,3 +53,31 @@ func testAccPreCheckRequiredEnvVars(t *testing.T) {
 		t.Fatal("APIO_TOKEN_ADMIN must be set for acceptance tests")
 	}
 }
+
+func TestDataSourceRead_unconfigured(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newDataSource := range []func() datasource.DataSource{
+		NewContactDataSource,
+		NewContactsDataSource,
+		NewDetailsDataSource,
+		NewCreditsDataSource,
+		NewInvoiceDataSource,
+		NewInvoicesDataSource,
+		NewClientDataSource,
+	} {
+		d := newDataSource()
+
+		metadata := datasource.MetadataResponse{}
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "account"}, &metadata)
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			resp := datasource.ReadResponse{}
+			d.Read(ctx, datasource.ReadRequest{}, &resp)
+
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("expected an error reading without a configured client")
+			}
+		})
+	}
+}
@@ -5,13 +5,23 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math/rand/v2"
+	"time"
 
+	"github.com/ans-group/sdk-go/pkg/connection"
 	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -20,6 +30,9 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AccountApplication{}
 var _ resource.ResourceWithImportState = &AccountApplication{}
+var _ resource.ResourceWithUpgradeState = &AccountApplication{}
+var _ resource.ResourceWithModifyPlan = &AccountApplication{}
+var _ resource.ResourceWithValidateConfig = &AccountApplication{}
 
 func NewAccountApplication() resource.Resource {
 	return &AccountApplication{}
@@ -27,24 +40,80 @@ func NewAccountApplication() resource.Resource {
 
 // AccountApplication defines the resource implementation.
 type AccountApplication struct {
-	client accountservice.AccountService
+	client *accountClient
+}
+
+// applicationFields maps Account API fields to attributes for validation errors.
+var applicationFields = map[string]path.Path{
+	"name":        path.Root("name"),
+	"description": path.Root("description"),
 }
 
 // AccountApplicationModel describes the resource data model.
 type AccountApplicationModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Key                types.String   `tfsdk:"key"`
+	KeyWOVersion       types.Int64    `tfsdk:"key_wo_version"`
+	Name               types.String   `tfsdk:"name"`
+	NamePrefix         types.String   `tfsdk:"name_prefix"`
+	Description        types.String   `tfsdk:"description"`
+	DeletionProtection types.Bool     `tfsdk:"deletion_protection"`
+	AdoptExisting      types.Bool     `tfsdk:"adopt_existing"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// accountApplicationModelV0 describes version 0 of the resource data model.
+type accountApplicationModelV0 struct {
 	ID          types.String `tfsdk:"id"`
 	Key         types.String `tfsdk:"key"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 }
 
+// uniqueName returns prefix followed by a suffix of the creation time and a
+// random number, so that applications replaced with create_before_destroy can
+// be told apart.
+func uniqueName(prefix string) string {
+	return fmt.Sprintf("%s%s%04x", prefix, time.Now().UTC().Format("20060102150405"), rand.N(0x10000))
+}
+
+// setKey sets the key returned by the Account API, unless key_wo_version keeps
+// it out of state. A warning is returned when the API returned no key.
+func (m *AccountApplicationModel) setKey(id string, key string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Key = types.StringNull()
+
+	if !m.KeyWOVersion.IsNull() {
+		return diags
+	}
+
+	if len(key) == 0 {
+		diags.AddWarning("Application Key Unavailable",
+			fmt.Sprintf("The Account API did not return a key for application %s, so key is null. "+
+				"Set key_wo_version to replace the application and issue a new key.", id))
+		return diags
+	}
+
+	m.Key = types.StringValue(key)
+
+	return diags
+}
+
+// setApplication sets the attributes stored by the Account API.
+func (m *AccountApplicationModel) setApplication(application accountservice.Application) {
+	m.Name = types.StringValue(application.Name)
+	m.Description = types.StringValue(application.Description)
+}
+
 func (r *AccountApplication) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_application"
 }
 
 // Schema defines the schema for the resource.
-func (r *AccountApplication) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *AccountApplication) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
@@ -54,36 +123,131 @@ func (r *AccountApplication) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "ID of created Application Key",
 			},
 			"key": schema.StringAttribute{
-				Computed: true,
+				Computed:  true,
+				Sensitive: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					nullWhenKeyWriteOnly(),
 				},
-				Description: "API Key",
+				Description: "API Key. Null when key_wo_version is set.",
+			},
+			"key_wo_version": schema.Int64Attribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(keyWOVersionChanged,
+						"Changing key_wo_version replaces the application, issuing a new key.",
+						"Changing `key_wo_version` replaces the application, issuing a new key."),
+				},
+				Description: "Keeps the key out of state when set. Fetch the key each run with the account_application_key " +
+					"ephemeral resource and pass it to write-only arguments. Changing the version replaces the application, " +
+					"issuing a new key.",
 			},
 			"name": schema.StringAttribute{
-				Required:    true,
-				Description: "Application name",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "Application name. Either name or name_prefix must be set.",
+			},
+			"name_prefix": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Creates a unique name beginning with this prefix, followed by the creation time and a random suffix. " +
+					"Conflicts with name.",
 			},
 			"description": schema.StringAttribute{
 				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
 				Description: "Application description",
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional: true,
+				Description: "Adopt an existing application with exactly the same name instead of creating one. " +
+					"The key of an adopted application cannot be read, so key is null. Only applies when creating the resource",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				Description: "Prevents the application from being destroyed or replaced. It must be set to false " +
+					"and applied before the application can be deleted. Defaults to false",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 		Description: "API Application Key resource",
 	}
 }
 
+// UpgradeState upgrades state written by prior schema versions.
+func (r *AccountApplication) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 predates the timeouts block.
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed: true,
+					},
+					"key": schema.StringAttribute{
+						Computed: true,
+					},
+					"name": schema.StringAttribute{
+						Required: true,
+					},
+					"description": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior accountApplicationModelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := AccountApplicationModel{
+					ID:                 prior.ID,
+					Key:                prior.Key,
+					KeyWOVersion:       types.Int64Null(),
+					Name:               prior.Name,
+					NamePrefix:         types.StringNull(),
+					Description:        types.StringValue(prior.Description.ValueString()),
+					DeletionProtection: types.BoolValue(false),
+					AdoptExisting:      types.BoolNull(),
+					Timeouts:           nullTimeouts(),
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+			},
+		},
+	}
+}
+
 func (r *AccountApplication) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(accountservice.AccountService)
+	client, ok := req.ProviderData.(*accountClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected accountservice.AccountService, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -94,7 +258,6 @@ func (r *AccountApplication) Configure(ctx context.Context, req resource.Configu
 
 func (r *AccountApplication) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var d AccountApplicationModel
-	service := r.client
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &d)...)
 
@@ -102,25 +265,54 @@ func (r *AccountApplication) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	createReq := accountservice.CreateApplicationRequest{
-		Name:        d.Name.ValueString(),
-		Description: d.Description.ValueString(),
+	createTimeout, diags := d.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Created AccountApplicationAPIModel: %+v", createReq))
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	tflog.Info(ctx, "Creating API Application")
-	createData, err := service.CreateApplication(createReq)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Creating API Application",
-			fmt.Sprint(err),
-		)
-		return
+	service := r.client.Service(ctx)
+
+	if !d.NamePrefix.IsNull() {
+		d.Name = types.StringValue(uniqueName(d.NamePrefix.ValueString()))
 	}
 
-	d.ID = types.StringValue(createData.ID)
-	d.Key = types.StringValue(createData.Key)
+	if d.AdoptExisting.ValueBool() {
+		existing, err := findApplicationsByName(service, d.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving API Applications", err, applicationFields)...)
+			return
+		}
+
+		switch len(existing) {
+		case 0:
+			tflog.Info(ctx, "No application to adopt, creating one")
+		case 1:
+			resp.Diagnostics.Append(r.adoptApplication(ctx, &d, existing[0])...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
+			return
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Multiple Applications Found",
+				fmt.Sprintf("%d applications are named %q, so none can be adopted. Rename all but one, or import "+
+					"the intended application by ID instead.", len(existing), d.Name.ValueString()))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.createApplication(ctx, &d, resp.Private)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	tflog.Trace(ctx, "created a resource")
 
@@ -129,42 +321,87 @@ func (r *AccountApplication) Create(ctx context.Context, req resource.CreateRequ
 
 func (r *AccountApplication) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var d AccountApplicationModel
-	service := r.client
 	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := d.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
 	tflog.Info(ctx, "Retrieving API Application", map[string]interface{}{
 		"id": d.ID.ValueString(),
 	})
 
+	if d.ID.IsNull() {
+		found, diags := findPendingApplication(ctx, service, &d, req.Private)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !found {
+			tflog.Info(ctx, "Unconfirmed API Application create was not applied, removing from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, pendingCreatePrivateKey, nil)...)
+	}
+
 	application, err := service.GetApplication(d.ID.ValueString())
 
+	if isNotFoundError(err) {
+		tflog.Info(ctx, "API Application not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Retrieving API Application",
-			fmt.Sprint(err),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving API Application", err, applicationFields)...)
 		return
 	}
 
-	d.Name = types.StringValue(application.Name)
-	d.Description = types.StringValue(application.Description)
+	d.setApplication(application)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
 }
 
 func (r *AccountApplication) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, d AccountApplicationModel
-	service := r.client
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
 	if !plan.Name.Equal(d.Name) || !plan.Description.Equal(d.Description) {
-		tflog.Info(ctx, "Updating Application Key Details", map[string]interface{}{
+		tflog.Info(ctx, "Updating API Application Details", map[string]interface{}{
 			"id":          plan.ID.ValueString(),
 			"name":        plan.Name.ValueString(),
 			"description": plan.Description.ValueString(),
@@ -175,29 +412,38 @@ func (r *AccountApplication) Update(ctx context.Context, req resource.UpdateRequ
 			Description: plan.Description.ValueString(),
 		}
 
-		err := service.UpdateApplication(d.ID.ValueString(), updateReq)
+		err := r.client.UpdateApplication(ctx, d.ID.ValueString(), updateReq)
 
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Updating API Application Details",
-				fmt.Sprint(err),
-			)
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating API Application Details", err, applicationFields)...)
 			return
 		}
 	}
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &d)...)
+	tflog.Info(ctx, "Refreshing API Application", map[string]interface{}{
+		"id": d.ID.ValueString(),
+	})
 
-	if resp.Diagnostics.HasError() {
+	application, err := service.GetApplication(d.ID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving API Application", err, applicationFields)...)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
+	plan.setApplication(application)
+
+	// The key is unknown when none was in state, such as after key_wo_version
+	// is removed, so it is read back.
+	if plan.Key.IsUnknown() {
+		resp.Diagnostics.Append(plan.setKey(application.ID, application.Key)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *AccountApplication) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var d AccountApplicationModel
-	service := r.client
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
 
@@ -205,6 +451,34 @@ func (r *AccountApplication) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, diags := d.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if d.DeletionProtection.ValueBool() {
+		resp.Diagnostics.Append(deletionProtectionDiagnostics(d.ID.ValueString(), "deleted")...)
+		return
+	}
+
+	service := r.client.Service(ctx)
+
+	// An unconfirmed create which has not been refreshed since is looked up,
+	// so that the application it may have made is not left behind.
+	if d.ID.IsNull() {
+		found, diags := findPendingApplication(ctx, service, &d, req.Private)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() || !found {
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Removing API Application")
 
 	id := d.ID.ValueString()
@@ -212,14 +486,231 @@ func (r *AccountApplication) Delete(ctx context.Context, req resource.DeleteRequ
 	err := service.DeleteApplication(id)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting Application",
-			fmt.Sprint(err),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Deleting Application", err, applicationFields)...)
 		return
 	}
 }
 
 func (r *AccountApplication) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deletion_protection"), false)...)
+}
+
+func (r *AccountApplication) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var name, namePrefix types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name_prefix"), &namePrefix)...)
+
+	if resp.Diagnostics.HasError() || name.IsUnknown() || namePrefix.IsUnknown() {
+		return
+	}
+
+	if name.IsNull() && namePrefix.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Missing Application Name",
+			"One of name or name_prefix must be set.")
+	}
+
+	if !name.IsNull() && !namePrefix.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("name_prefix"), "Conflicting Application Name",
+			"name_prefix cannot be set with name.")
+	}
+
+	var adoptExisting types.Bool
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("adopt_existing"), &adoptExisting)...)
+
+	if adoptExisting.ValueBool() && !namePrefix.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("adopt_existing"), "Conflicting Application Adoption",
+			"adopt_existing requires name, as names generated from name_prefix are never shared with an existing application.")
+	}
+}
+
+// adoptApplication takes ownership of the existing application, updating it to
+// match d.
+func (r *AccountApplication) adoptApplication(ctx context.Context, d *AccountApplicationModel, existing accountservice.Application) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Info(ctx, "Adopting API Application", map[string]interface{}{
+		"id": existing.ID,
+	})
+
+	err := r.client.UpdateApplication(ctx, existing.ID, accountservice.UpdateApplicationRequest{
+		Name:        d.Name.ValueString(),
+		Description: d.Description.ValueString(),
+	})
+	if err != nil {
+		return apiErrorDiagnostics("Error Updating Adopted API Application", err, applicationFields)
+	}
+
+	d.ID = types.StringValue(existing.ID)
+	d.Key = types.StringNull()
+
+	diags.AddWarning("Application Key Unavailable",
+		fmt.Sprintf("Adopted existing application %s. Its key cannot be read from the API, so key is null. "+
+			"Set key_wo_version to replace the application and issue a new key.", existing.ID))
+
+	return diags
+}
+
+// pendingCreatePrivateKey is the private state key holding the pendingCreate
+// of an application whose create was not confirmed by the Account API.
+const pendingCreatePrivateKey = "pending_create"
+
+// createApplication creates the application described by d, setting its ID and
+// key. The create is recorded in private before it is sent. If the API does not
+// confirm it, and the application cannot be looked up, the ID is left null and
+// the record kept, so that the next refresh finds the application rather than
+// the next apply creating another.
+func (r *AccountApplication) createApplication(ctx context.Context, d *AccountApplicationModel, private privateStateSetter) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	service := r.client.Service(ctx)
+
+	pending, err := newPendingCreate(service, d.Name.ValueString())
+	if err != nil {
+		return apiErrorDiagnostics("Error Retrieving API Applications", err, applicationFields)
+	}
+
+	value, err := json.Marshal(pending)
+	if err != nil {
+		diags.AddError("Error Recording API Application Create", err.Error())
+		return diags
+	}
+
+	diags.Append(private.SetKey(ctx, pendingCreatePrivateKey, value)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	createReq := accountservice.CreateApplicationRequest{
+		Name:        d.Name.ValueString(),
+		Description: d.Description.ValueString(),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Created AccountApplicationAPIModel: %+v", createReq))
+
+	tflog.Info(ctx, "Creating API Application")
+	application, err := r.client.CreateApplication(ctx, createReq, pending)
+
+	var unconfirmed *createUnconfirmedError
+	if errors.As(err, &unconfirmed) {
+		d.ID = types.StringNull()
+		d.Key = types.StringNull()
+
+		diags.AddWarning("API Application Create Not Confirmed",
+			fmt.Sprintf("%s\n\nThe Account API did not confirm the create of application %q, and it could not be "+
+				"looked up afterwards. If it was created, the next refresh finds it and keeps it in state. "+
+				"Otherwise, the next apply creates it.", err, d.Name.ValueString()))
+		return diags
+	}
+
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Error Creating API Application", err, applicationFields)...)
+		return diags
+	}
+
+	diags.Append(private.SetKey(ctx, pendingCreatePrivateKey, nil)...)
+
+	d.ID = types.StringValue(application.ID)
+	diags.Append(d.setKey(application.ID, application.Key)...)
+
+	return diags
+}
+
+// findPendingApplication looks up the application made by a create which was
+// not confirmed, as recorded in private, setting the ID and key of d. It
+// reports whether the create made one.
+func findPendingApplication(ctx context.Context, service accountservice.AccountService, d *AccountApplicationModel, private privateStateGetter) (bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, pendingCreatePrivateKey)
+	if diags.HasError() || len(value) == 0 {
+		return false, diags
+	}
+
+	var pending pendingCreate
+	if err := json.Unmarshal(value, &pending); err != nil {
+		diags.AddError("Error Reading API Application Create", err.Error())
+		return false, diags
+	}
+
+	tflog.Info(ctx, "Looking for API Application from an unconfirmed create", map[string]interface{}{
+		"name": pending.Name,
+	})
+
+	application, err := pending.find(service)
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Error Retrieving API Application", err, applicationFields)...)
+		return false, diags
+	}
+
+	if len(application.ID) == 0 {
+		return false, diags
+	}
+
+	d.ID = types.StringValue(application.ID)
+	diags.Append(d.setKey(application.ID, application.Key)...)
+
+	return true, diags
+}
+
+// findApplicationsByName returns the applications named exactly name.
+func findApplicationsByName(service accountservice.AccountService, name string) ([]accountservice.Application, error) {
+	applications, err := service.GetApplications(connection.APIRequestParameters{
+		Filtering: []connection.APIRequestFiltering{
+			*connection.NewAPIRequestFiltering("name", connection.EQOperator, []string{name}),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]accountservice.Application, 0, len(applications))
+	for _, application := range applications {
+		if application.Name == name {
+			matches = append(matches, application)
+		}
+	}
+
+	return matches, nil
+}
+
+// ModifyPlan fails plans which replace an application with deletion
+// protection enabled, rather than leaving them to fail part way through apply.
+func (r *AccountApplication) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AccountApplicationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.DeletionProtection.ValueBool() && applicationReplaced(state, plan) {
+		resp.Diagnostics.Append(deletionProtectionDiagnostics(state.ID.ValueString(), "replaced")...)
+	}
+}
+
+// applicationReplaced reports whether plan replaces the application in state.
+// The framework only reports replacements after ModifyPlan, so every attribute
+// which requires replacement is compared here.
+func applicationReplaced(state AccountApplicationModel, plan AccountApplicationModel) bool {
+	return !plan.NamePrefix.Equal(state.NamePrefix) || keyWOVersionReplaces(state.KeyWOVersion, plan.KeyWOVersion)
+}
+
+// deletionProtectionDiagnostics reports that application id cannot be deleted
+// or replaced, as given by action, while deletion protection is enabled.
+func deletionProtectionDiagnostics(id string, action string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddError("Application Deletion Protection Enabled",
+		fmt.Sprintf("Application %s cannot be %s while deletion_protection is true. "+
+			"Set deletion_protection = false and apply that change first.", id, action))
+
+	return diags
 }
@@ -8,9 +8,13 @@ import (
 	"fmt"
 
 	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -18,6 +22,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApplicationIPRestriction{}
 var _ resource.ResourceWithImportState = &ApplicationIPRestriction{}
+var _ resource.ResourceWithUpgradeState = &ApplicationIPRestriction{}
 
 func NewApplicationIPRestriction() resource.Resource {
 	return &ApplicationIPRestriction{}
@@ -25,13 +30,28 @@ func NewApplicationIPRestriction() resource.Resource {
 
 // ApplicationApplication defines the resource implementation.
 type ApplicationIPRestriction struct {
-	client accountservice.AccountService
+	client *accountClient
+}
+
+// restrictionFields maps Account API fields to attributes for validation errors.
+var restrictionFields = map[string]path.Path{
+	"ip_restriction_type": path.Root("type"),
+	"ip_ranges":           path.Root("ranges"),
 }
 
 type ApplicationIPRestrictionModel struct {
 	ApplicationID types.String   `tfsdk:"application_id"`
 	Type          types.String   `tfsdk:"type"`
 	Ranges        []types.String `tfsdk:"ranges"`
+	OnDestroy     types.String   `tfsdk:"on_destroy"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// applicationIPRestrictionModelV0 describes version 0 of the resource data model.
+type applicationIPRestrictionModelV0 struct {
+	ApplicationID types.String   `tfsdk:"application_id"`
+	Type          types.String   `tfsdk:"type"`
+	Ranges        []types.String `tfsdk:"ranges"`
 }
 
 func (r *ApplicationIPRestriction) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,12 +59,16 @@ func (r *ApplicationIPRestriction) Metadata(ctx context.Context, req resource.Me
 }
 
 // Schema defines the schema for the resource.
-func (r *ApplicationIPRestriction) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ApplicationIPRestriction) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"application_id": schema.StringAttribute{
-				Required:    true,
-				Description: "ID of application to apply restrictions to",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "ID of application to apply restrictions to. Changing it moves the restrictions to the new application, removing them from the old one.",
 			},
 			"type": schema.StringAttribute{
 				Required:    true,
@@ -55,22 +79,73 @@ func (r *ApplicationIPRestriction) Schema(_ context.Context, _ resource.SchemaRe
 				Required:    true,
 				Description: "Defines the IPs or ranges",
 			},
+			"on_destroy": onDestroyAttribute("IP restrictions"),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 		Description: "Defines an allowlist or denylist of IP ranges to restrict usage of an Application Key.",
 	}
 }
 
+// UpgradeState upgrades state written by prior schema versions.
+func (r *ApplicationIPRestriction) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 predates the timeouts block.
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"application_id": schema.StringAttribute{
+						Required: true,
+					},
+					"type": schema.StringAttribute{
+						Required: true,
+					},
+					"ranges": schema.ListAttribute{
+						ElementType: types.StringType,
+						Required:    true,
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior applicationIPRestrictionModelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := ApplicationIPRestrictionModel{
+					ApplicationID: prior.ApplicationID,
+					Type:          prior.Type,
+					Ranges:        prior.Ranges,
+					OnDestroy:     types.StringValue(onDestroyClear),
+					Timeouts:      nullTimeouts(),
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+			},
+		},
+	}
+}
+
 func (r *ApplicationIPRestriction) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(accountservice.AccountService)
+	client, ok := req.ProviderData.(*accountClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected accountservice.AccountService, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -80,7 +155,6 @@ func (r *ApplicationIPRestriction) Configure(ctx context.Context, req resource.C
 
 func (r *ApplicationIPRestriction) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var d ApplicationIPRestrictionModel
-	service := r.client
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &d)...)
 
@@ -88,19 +162,41 @@ func (r *ApplicationIPRestriction) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := d.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
+	original, err := service.GetApplicationRestrictions(d.ApplicationID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Application Restrictions", err, restrictionFields)...)
+		return
+	}
+
+	resp.Diagnostics.Append(setOriginal(ctx, resp.Private, original)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, "Setting API Application Restriction")
 	setRestrictionReq := accountservice.SetRestrictionRequest{
 		IPRestrictionType: d.Type.ValueString(),
 		IPRanges:          expandArray(ctx, d.Ranges),
 	}
 
-	err := service.SetApplicationRestrictions(d.ApplicationID.ValueString(), setRestrictionReq)
+	err = service.SetApplicationRestrictions(d.ApplicationID.ValueString(), setRestrictionReq)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Setting Application Restrictions",
-			fmt.Sprint(err),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Setting Application Restrictions", err, restrictionFields)...)
 		return
 	}
 
@@ -109,7 +205,6 @@ func (r *ApplicationIPRestriction) Create(ctx context.Context, req resource.Crea
 
 func (r *ApplicationIPRestriction) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var d ApplicationIPRestrictionModel
-	service := r.client
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
 
@@ -117,24 +212,36 @@ func (r *ApplicationIPRestriction) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	readTimeout, diags := d.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
 	restrictions, err := service.GetApplicationRestrictions(d.ApplicationID.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Retrieving Application Restrictions",
-			fmt.Sprint(err),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Application Restrictions", err, restrictionFields)...)
 		return
 	}
 
 	d.Type = types.StringValue(restrictions.IPRestrictionType)
-	d.Ranges = readApiArray(ctx, restrictions.IPRanges)
+
+	// Keep the configured ranges when the API returns them in another order or form.
+	if !rangesEquivalent(expandArray(ctx, d.Ranges), restrictions.IPRanges) {
+		d.Ranges = readApiArray(ctx, restrictions.IPRanges)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
 }
 
 func (r *ApplicationIPRestriction) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, d ApplicationIPRestrictionModel
-	service := r.client
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -143,6 +250,18 @@ func (r *ApplicationIPRestriction) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
 	tflog.Info(ctx, "Setting API Application Restriction")
 
 	setRestrictionReq := accountservice.SetRestrictionRequest{
@@ -153,10 +272,7 @@ func (r *ApplicationIPRestriction) Update(ctx context.Context, req resource.Upda
 	err := service.SetApplicationRestrictions(plan.ApplicationID.ValueString(), setRestrictionReq)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Setting Application Restrictions",
-			fmt.Sprint(err),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Setting Application Restrictions", err, restrictionFields)...)
 		return
 	}
 
@@ -165,7 +281,6 @@ func (r *ApplicationIPRestriction) Update(ctx context.Context, req resource.Upda
 
 func (r *ApplicationIPRestriction) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var d ApplicationIPRestrictionModel
-	service := r.client
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
 
@@ -173,27 +288,103 @@ func (r *ApplicationIPRestriction) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := d.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if d.OnDestroy.ValueString() == onDestroyAbandon {
+		tflog.Info(ctx, "Abandoning IP Restrictions")
+		return
+	}
+
+	service := r.client.Service(ctx)
+
 	tflog.Info(ctx, "Removing IP Restrictions")
 
 	_, err := service.GetApplication(d.ApplicationID.ValueString())
 
+	if isNotFoundError(err) {
+		tflog.Info(ctx, "Application not found, nothing to remove")
+		return
+	}
+
 	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving API Application", err, restrictionFields)...)
 		return
 	}
 
-	tflog.Info(ctx, "Application found, removing services")
+	if d.OnDestroy.ValueString() == onDestroyRestore {
+		restored, diags := restoreApplicationRestrictions(ctx, service, d.ApplicationID.ValueString(), req.Private)
+		resp.Diagnostics.Append(diags...)
+
+		if restored || resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Info(ctx, "Application found, removing restrictions")
 
 	err = service.DeleteApplicationRestrictions(d.ApplicationID.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Removing Application Restrictions",
-			fmt.Sprint(err),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing Application Restrictions", err, restrictionFields)...)
 		return
 	}
 }
 
+// restoreApplicationRestrictions puts back the IP restrictions recorded in
+// private when the resource was created or imported. It reports false when
+// there are none to restore, leaving the restrictions to be cleared.
+func restoreApplicationRestrictions(ctx context.Context, service accountservice.AccountService, appID string, private privateStateGetter) (bool, diag.Diagnostics) {
+	var original accountservice.ApplicationRestriction
+
+	found, diags := getOriginal(ctx, private, &original)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if !found {
+		diags.AddWarning("Original IP Restrictions Unknown",
+			fmt.Sprintf("No IP restrictions were recorded for application %s when the resource was created or imported, "+
+				"so they have been cleared instead of restored.", appID))
+	}
+
+	if len(original.IPRanges) == 0 {
+		return false, diags
+	}
+
+	tflog.Info(ctx, "Application found, restoring original restrictions")
+
+	err := service.SetApplicationRestrictions(appID, accountservice.SetRestrictionRequest{
+		IPRestrictionType: original.IPRestrictionType,
+		IPRanges:          original.IPRanges,
+	})
+
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Error Restoring Application Restrictions", err, restrictionFields)...)
+	}
+
+	return true, diags
+}
+
 func (r *ApplicationIPRestriction) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("application_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_destroy"), onDestroyClear)...)
+
+	// The restrictions in place at import are those restored on destroy.
+	restrictions, err := r.client.Service(ctx).GetApplicationRestrictions(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Application Restrictions", err, restrictionFields)...)
+		return
+	}
+
+	resp.Diagnostics.Append(setOriginal(ctx, resp.Private, restrictions)...)
 }
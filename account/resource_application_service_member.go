@@ -0,0 +1,414 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ApplicationServiceMember{}
+var _ resource.ResourceWithImportState = &ApplicationServiceMember{}
+var _ resource.ResourceWithModifyPlan = &ApplicationServiceMember{}
+
+// errServiceAlreadyGranted is returned when creating a member for a service the
+// application already has roles on.
+var errServiceAlreadyGranted = errors.New("service already granted")
+
+func NewApplicationServiceMember() resource.Resource {
+	return &ApplicationServiceMember{}
+}
+
+// ApplicationServiceMember defines the resource implementation.
+type ApplicationServiceMember struct {
+	client *accountClient
+}
+
+// serviceMemberFields maps Account API fields to attributes for validation errors.
+var serviceMemberFields = map[string]path.Path{
+	"scopes": path.Root("roles"),
+}
+
+// ApplicationServiceMemberModel describes the resource data model.
+type ApplicationServiceMemberModel struct {
+	ID                             types.String   `tfsdk:"id"`
+	ApplicationID                  types.String   `tfsdk:"application_id"`
+	Service                        types.String   `tfsdk:"service"`
+	Roles                          []types.String `tfsdk:"roles"`
+	AcknowledgePrivilegeEscalation types.Bool     `tfsdk:"acknowledge_privilege_escalation"`
+	Timeouts                       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ApplicationServiceMember) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_application_service"
+}
+
+// Schema defines the schema for the resource.
+func (r *ApplicationServiceMember) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Description: "ID of the service grant, in the form application_id/service",
+			},
+			"application_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "ID of application to grant the service to",
+			},
+			"service": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Service name",
+			},
+			"roles": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Roles granted on the service",
+			},
+			"acknowledge_privilege_escalation": schema.BoolAttribute{
+				Optional: true,
+				Description: "Allow a plan to grant roles the application does not already have when the provider " +
+					"sets error_on_privilege_escalation. Defaults to false",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+		Description: "Grants roles on a single service to an Application Key, leaving other services untouched. " +
+			"Do not use with account_application_services on the same application, which manages every service.",
+	}
+}
+
+func (r *ApplicationServiceMember) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*accountClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// scope returns the service scope managed by the resource.
+func (m ApplicationServiceMemberModel) scope(ctx context.Context) accountservice.ApplicationServiceScope {
+	return accountservice.ApplicationServiceScope{
+		Service: m.Service.ValueString(),
+		Roles:   expandArray(ctx, m.Roles),
+	}
+}
+
+// ModifyPlan reports roles a plan grants on the service which the application
+// does not already have, as for account_application_services.
+func (r *ApplicationServiceMember) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destroying the resource is already shown as such in the plan.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	for _, name := range []string{"service", "roles"} {
+		value, _, err := tftypes.WalkAttributePath(req.Plan.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Plan", err.Error())
+			return
+		}
+
+		if v, ok := value.(tftypes.Value); ok && !v.IsFullyKnown() {
+			tflog.Debug(ctx, "Planned roles are unknown, skipping privilege escalation check")
+			return
+		}
+	}
+
+	var plan ApplicationServiceMemberModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Planning reads the application's grants, so is bounded by the read timeout.
+	readTimeout, diags := plan.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	var state ApplicationServiceMemberModel
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var prior []accountservice.ApplicationServiceScope
+
+	switch {
+	case !req.State.Raw.IsNull() && plan.ApplicationID.Equal(state.ApplicationID) && plan.Service.Equal(state.Service):
+		prior = []accountservice.ApplicationServiceScope{state.scope(ctx)}
+	case !plan.ApplicationID.IsUnknown():
+		// The grant is created, or moved to another application or service,
+		// which starts with the application's current grants.
+		current, err := currentApplicationScopes(r.client.Service(ctx), plan.ApplicationID.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Application Services", err, serviceMemberFields)...)
+			return
+		}
+
+		prior = current
+	}
+
+	resp.Diagnostics.Append(privilegeEscalationDiagnostics(
+		plan.ApplicationID.ValueString(),
+		escalatedScopes(prior, []accountservice.ApplicationServiceScope{plan.scope(ctx)}),
+		r.client.errorOnPrivilegeEscalation && !plan.AcknowledgePrivilegeEscalation.ValueBool(),
+	)...)
+}
+
+func (r *ApplicationServiceMember) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var d ApplicationServiceMemberModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := d.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, "Granting API Application Service", map[string]interface{}{
+		"id":      d.ApplicationID.ValueString(),
+		"service": d.Service.ValueString(),
+	})
+
+	err := r.client.UpdateApplicationScopes(ctx, d.ApplicationID.ValueString(), func(scopes []accountservice.ApplicationServiceScope) ([]accountservice.ApplicationServiceScope, error) {
+		for _, scope := range scopes {
+			if scope.Service == d.Service.ValueString() {
+				return nil, errServiceAlreadyGranted
+			}
+		}
+
+		return append(scopes, d.scope(ctx)), nil
+	})
+
+	if errors.Is(err, errServiceAlreadyGranted) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("service"),
+			"Application Service Already Granted",
+			fmt.Sprintf("Application %[1]s already has roles on %[2]s. Import the existing grant with "+
+				"terraform import account_application_service.<name> %[1]s/%[2]s", d.ApplicationID.ValueString(), d.Service.ValueString()),
+		)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Granting Application Service", err, serviceMemberFields)...)
+		return
+	}
+
+	d.ID = types.StringValue(d.ApplicationID.ValueString() + "/" + d.Service.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
+}
+
+func (r *ApplicationServiceMember) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var d ApplicationServiceMemberModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := d.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
+	tflog.Info(ctx, "Retrieving API Application Services", map[string]interface{}{
+		"id": d.ApplicationID.ValueString(),
+	})
+
+	services, err := service.GetApplicationServices(d.ApplicationID.ValueString())
+
+	if isNotFoundError(err) {
+		tflog.Info(ctx, "Application not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Application Services", err, serviceMemberFields)...)
+		return
+	}
+
+	for _, scope := range normalizeApplicationScope(services.Scopes) {
+		if scope.Service != d.Service.ValueString() {
+			continue
+		}
+
+		// Keep the configured order when the roles are unchanged.
+		stateRoles := expandArray(ctx, d.Roles)
+		sort.Strings(stateRoles)
+
+		if !reflect.DeepEqual(stateRoles, scope.Roles) {
+			d.Roles = readApiArray(ctx, scope.Roles)
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
+		return
+	}
+
+	tflog.Info(ctx, "Service not granted to application, removing from state")
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ApplicationServiceMember) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var d ApplicationServiceMemberModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := d.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, "Updating API Application Service roles", map[string]interface{}{
+		"id":      d.ApplicationID.ValueString(),
+		"service": d.Service.ValueString(),
+	})
+
+	err := r.client.UpdateApplicationScopes(ctx, d.ApplicationID.ValueString(), func(scopes []accountservice.ApplicationServiceScope) ([]accountservice.ApplicationServiceScope, error) {
+		return append(withoutService(scopes, d.Service.ValueString()), d.scope(ctx)), nil
+	})
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Granting Application Service", err, serviceMemberFields)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
+}
+
+func (r *ApplicationServiceMember) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var d ApplicationServiceMemberModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := d.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	tflog.Info(ctx, "Revoking API Application Service", map[string]interface{}{
+		"id":      d.ApplicationID.ValueString(),
+		"service": d.Service.ValueString(),
+	})
+
+	err := r.client.UpdateApplicationScopes(ctx, d.ApplicationID.ValueString(), func(scopes []accountservice.ApplicationServiceScope) ([]accountservice.ApplicationServiceScope, error) {
+		return withoutService(scopes, d.Service.ValueString()), nil
+	})
+
+	if isNotFoundError(err) {
+		tflog.Info(ctx, "Application not found, nothing to remove")
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Revoking Application Service", err, serviceMemberFields)...)
+		return
+	}
+}
+
+func (r *ApplicationServiceMember) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	applicationID, serviceName, ok := strings.Cut(req.ID, "/")
+	if !ok || len(applicationID) == 0 || len(serviceName) == 0 {
+		resp.Diagnostics.AddError(
+			"Invalid Application Service ID",
+			fmt.Sprintf("Expected an ID in the form application_id/service, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), applicationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service"), serviceName)...)
+}
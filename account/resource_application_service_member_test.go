@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccApplicationServiceMember_basic(t *testing.T) {
+	applicationName := acctest.RandomWithPrefix("tftest")
+	ecloudName := "account_application_service.ecloud"
+
+	service := AccTestingClient{}
+	service.Configure()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             service.testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccResourceApplicationServiceMemberConfig(applicationName, true),
+				Check: resource.ComposeTestCheckFunc(
+					service.testAccCheckApplicationServiceGranted("account_application.test-application", "ecloud", true),
+					service.testAccCheckApplicationServiceGranted("account_application.test-application", "account", true),
+					resource.TestCheckResourceAttr(ecloudName, "roles.#", "2"),
+				),
+			},
+			{
+				Config: providerConfig + testAccResourceApplicationServiceMemberConfig(applicationName, false),
+				Check: resource.ComposeTestCheckFunc(
+					service.testAccCheckApplicationServiceGranted("account_application.test-application", "ecloud", true),
+					service.testAccCheckApplicationServiceGranted("account_application.test-application", "account", false),
+				),
+			},
+			{
+				ResourceName:            ecloudName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+// testAccCheckApplicationServiceGranted checks whether application n has roles on serviceName.
+func (r *AccTestingClient) testAccCheckApplicationServiceGranted(n string, serviceName string, granted bool) resource.TestCheckFunc {
+	service := r.client
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Application not found: %s", n)
+		}
+
+		services, err := service.GetApplicationServices(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, scope := range services.Scopes {
+			if scope.Service == serviceName {
+				found = true
+			}
+		}
+
+		if found != granted {
+			return fmt.Errorf("expected service %s granted to be %t, got scopes %+v", serviceName, granted, services.Scopes)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceApplicationServiceMemberConfig(applicationName string, withAccount bool) string {
+	config := fmt.Sprintf(`
+		resource "account_application" "test-application"{
+			name = "%[1]s"
+		}
+
+		resource "account_application_service" "ecloud" {
+			application_id = account_application.test-application.id
+			service        = "ecloud"
+			roles          = ["read", "write"]
+		}
+		`, applicationName,
+	)
+
+	if withAccount {
+		config += `
+		resource "account_application_service" "account" {
+			application_id = account_application.test-application.id
+			service        = "account"
+			roles          = ["read"]
+		}
+		`
+	}
+
+	return config
+}
+
+func TestApplicationServiceMember_ModifyPlan(t *testing.T) {
+	current := []accountservice.ApplicationServiceScope{{Service: "ecloud", Roles: []string{"read"}}}
+
+	cases := map[string]struct {
+		state             *ApplicationServiceMemberModel
+		plan              ApplicationServiceMemberModel
+		errorOnEscalation bool
+		err               bool
+	}{
+		"create within current grants": {
+			plan:              ApplicationServiceMemberModel{Service: types.StringValue("ecloud"), Roles: testStringValues("read")},
+			errorOnEscalation: true,
+		},
+		"create escalating current grants": {
+			plan:              ApplicationServiceMemberModel{Service: types.StringValue("ecloud"), Roles: testStringValues("read", "admin")},
+			errorOnEscalation: true,
+			err:               true,
+		},
+		"create on an ungranted service": {
+			plan:              ApplicationServiceMemberModel{Service: types.StringValue("pss"), Roles: testStringValues("read")},
+			errorOnEscalation: true,
+			err:               true,
+		},
+		"create escalating acknowledged": {
+			plan: ApplicationServiceMemberModel{
+				Service:                        types.StringValue("pss"),
+				Roles:                          testStringValues("read"),
+				AcknowledgePrivilegeEscalation: types.BoolValue(true),
+			},
+			errorOnEscalation: true,
+		},
+		"update escalating state": {
+			state:             &ApplicationServiceMemberModel{Service: types.StringValue("pss"), Roles: testStringValues("read")},
+			plan:              ApplicationServiceMemberModel{Service: types.StringValue("pss"), Roles: testStringValues("read", "write")},
+			errorOnEscalation: true,
+			err:               true,
+		},
+		"update within state": {
+			state:             &ApplicationServiceMemberModel{Service: types.StringValue("pss"), Roles: testStringValues("read", "write")},
+			plan:              ApplicationServiceMemberModel{Service: types.StringValue("pss"), Roles: testStringValues("read")},
+			errorOnEscalation: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := testAccountClient(t, testApplicationServicesHandler(t, current))
+			client.errorOnPrivilegeEscalation = c.errorOnEscalation
+			r := &ApplicationServiceMember{client: client}
+
+			req := fwresource.ModifyPlanRequest{
+				Plan:  testResourcePlan(t, r, testModel(c.plan, testApplicationServiceMemberDefaults)),
+				State: testResourceState(t, r, nil),
+			}
+			if c.state != nil {
+				req.State = testResourceState(t, r, testModel(*c.state, testApplicationServiceMemberDefaults))
+			}
+			resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() != c.err {
+				t.Errorf("expected error=%t, got %v", c.err, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// testApplicationServiceMemberDefaults are the attributes testModel fills in
+// account_application_service models.
+var testApplicationServiceMemberDefaults = map[string]attr.Value{
+	"application_id": types.StringValue("app-1"),
+}
+
+func testStringValues(values ...string) []types.String {
+	elements := make([]types.String, len(values))
+	for i, v := range values {
+		elements[i] = types.StringValue(v)
+	}
+
+	return elements
+}
@@ -1,11 +1,20 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
 	"testing"
 
 	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -31,6 +40,136 @@ func TestAccApplicationService_basic(t *testing.T) {
 	})
 }
 
+func TestAccApplicationService_retarget(t *testing.T) {
+	resourceName := "account_application_services.test-application-services"
+
+	service := AccTestingClient{}
+	service.Configure()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             service.testAccCheckApplicationServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccResourceApplicationServiceConfig_retarget("first"),
+				Check: resource.ComposeTestCheckFunc(
+					service.testAccCheckApplicationServiceExists(t, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "account_application.first", "id"),
+				),
+			},
+			{
+				Config: providerConfig + testAccResourceApplicationServiceConfig_retarget("second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					service.testAccCheckApplicationServiceExists(t, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "account_application.second", "id"),
+					service.testAccCheckApplicationServicesEmpty(t, "account_application.first"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationService_preset(t *testing.T) {
+	resourceName := "account_application_services.test-application-services"
+
+	service := AccTestingClient{}
+	service.Configure()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             service.testAccCheckApplicationServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccResourceApplicationServiceConfig_preset(),
+				Check: resource.ComposeTestCheckFunc(
+					service.testAccCheckApplicationServiceExists(t, resourceName),
+					service.testAccCheckApplicationServiceGranted("account_application.test-application", "ecloud", true),
+					service.testAccCheckApplicationServiceGranted("account_application.test-application", "account", true),
+				),
+			},
+			{
+				Config:   providerConfig + testAccResourceApplicationServiceConfig_preset(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestEscalatedScopes(t *testing.T) {
+	prior := []accountservice.ApplicationServiceScope{
+		{Service: "ecloud", Roles: []string{"read"}},
+		{Service: "account", Roles: []string{"read", "write"}},
+	}
+	planned := []accountservice.ApplicationServiceScope{
+		{Service: "ecloud", Roles: []string{"admin", "read"}},
+		{Service: "account", Roles: []string{"read"}},
+		{Service: "pss", Roles: []string{"read"}},
+	}
+
+	expected := []accountservice.ApplicationServiceScope{
+		{Service: "ecloud", Roles: []string{"admin"}},
+		{Service: "pss", Roles: []string{"read"}},
+	}
+
+	if actual := escalatedScopes(prior, planned); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %+v, got %+v", expected, actual)
+	}
+
+	if actual := escalatedScopes(planned, prior); len(actual) != 1 || actual[0].Service != "account" {
+		t.Errorf("expected only the account write role, got %+v", actual)
+	}
+}
+
+func TestPrivilegeEscalationDiagnostics(t *testing.T) {
+	escalated := []accountservice.ApplicationServiceScope{
+		{Service: "ecloud", Roles: []string{"admin", "write"}},
+	}
+
+	if diags := privilegeEscalationDiagnostics("app", nil, true); len(diags) != 0 {
+		t.Errorf("expected no diagnostics without escalation, got %+v", diags)
+	}
+
+	diags := privilegeEscalationDiagnostics("app", escalated, false)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %+v", diags)
+	}
+
+	if detail := diags[0].Detail(); !strings.Contains(detail, "ecloud: admin, write") {
+		t.Errorf("expected detail to list the granted roles, got %q", detail)
+	}
+
+	if diags := privilegeEscalationDiagnostics("app", escalated, true); diags.ErrorsCount() != 1 {
+		t.Errorf("expected a single error, got %+v", diags)
+	}
+}
+
+// testAccCheckApplicationServicesEmpty checks that application n has no services mapped.
+func (r *AccTestingClient) testAccCheckApplicationServicesEmpty(t *testing.T, n string) resource.TestCheckFunc {
+	service := r.client
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			t.Fatalf("Application not found: %s", n)
+		}
+
+		services, err := service.GetApplicationServices(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(services.Scopes) > 0 {
+			return fmt.Errorf("Application with ID [%s] still has services %+v", rs.Primary.ID, services.Scopes)
+		}
+
+		return nil
+	}
+}
+
 func (r *AccTestingClient) testAccCheckApplicationServiceExists(t *testing.T, n string) resource.TestCheckFunc {
 	service := r.client
 	return func(s *terraform.State) error {
@@ -100,3 +239,124 @@ func testAccResourceApplicationServiceConfig_basic(serviceName string) string {
 		`, serviceName,
 	)
 }
+
+func testAccResourceApplicationServiceConfig_retarget(target string) string {
+	return fmt.Sprintf(`
+		resource "account_application" "first"{
+			name = "tftst-app-first"
+		}
+
+		resource "account_application" "second"{
+			name = "tftst-app-second"
+		}
+
+		resource "account_application_services" "test-application-services" {
+			application_id = account_application.%s.id
+			service {
+					name = "ecloud"
+					roles = [
+						"read"
+					]
+			}
+		}
+		`, target,
+	)
+}
+
+func testAccResourceApplicationServiceConfig_preset() string {
+	return `
+		resource "account_application" "test-application"{
+			name = "tftst-app-preset"
+		}
+
+		resource "account_application_services" "test-application-services" {
+			application_id  = account_application.test-application.id
+			preset          = "full_access"
+			preset_services = ["ecloud"]
+			service {
+					name = "account"
+					roles = [
+						"read"
+					]
+			}
+		}
+		`
+}
+
+// testApplicationServicesHandler serves the current scopes of application app-1.
+func testApplicationServicesHandler(t *testing.T, scopes []accountservice.ApplicationServiceScope) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/account/v1/applications/app-1/services" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": accountservice.ApplicationServiceMapping{Scopes: scopes},
+		})
+	}
+}
+
+func TestApplicationServiceMapping_ModifyPlan(t *testing.T) {
+	current := []accountservice.ApplicationServiceScope{{Service: "ecloud", Roles: []string{"read"}}}
+
+	cases := map[string]struct {
+		state             *ApplicationServiceMappingModel
+		plan              ApplicationServiceMappingModel
+		warning           bool
+		err               bool
+		errorOnEscalation bool
+	}{
+		"create within current grants": {
+			plan: ApplicationServiceMappingModel{Services: testScopeList(map[string][]string{"ecloud": {"read"}})},
+		},
+		"create escalating current grants": {
+			plan:              ApplicationServiceMappingModel{Services: testScopeList(map[string][]string{"ecloud": {"read", "write"}})},
+			errorOnEscalation: true,
+			err:               true,
+		},
+		"create escalating acknowledged": {
+			plan: ApplicationServiceMappingModel{
+				Services:                       testScopeList(map[string][]string{"ecloud": {"admin"}}),
+				AcknowledgePrivilegeEscalation: types.BoolValue(true),
+			},
+			errorOnEscalation: true,
+			warning:           true,
+		},
+		"update against state": {
+			state:   &ApplicationServiceMappingModel{Services: testScopeList(map[string][]string{"ecloud": {"read", "write"}})},
+			plan:    ApplicationServiceMappingModel{Services: testScopeList(map[string][]string{"ecloud": {"write"}, "pss": {"read"}})},
+			warning: true,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := testAccountClient(t, testApplicationServicesHandler(t, current))
+			client.errorOnPrivilegeEscalation = c.errorOnEscalation
+			r := &ApplicationServiceMapping{client: client}
+
+			req := fwresource.ModifyPlanRequest{
+				Plan:  testResourcePlan(t, r, testModel(c.plan, testApplicationServiceMappingDefaults)),
+				State: testResourceState(t, r, nil),
+			}
+			if c.state != nil {
+				req.State = testResourceState(t, r, testModel(*c.state, testApplicationServiceMappingDefaults))
+			}
+			resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() != c.err || (resp.Diagnostics.WarningsCount() > 0) != c.warning {
+				t.Errorf("expected error=%t warning=%t, got %v", c.err, c.warning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// testApplicationServiceMappingDefaults are the attributes testModel fills in
+// account_application_services models.
+var testApplicationServiceMappingDefaults = map[string]attr.Value{
+	"application_id": types.StringValue("app-1"),
+	"on_destroy":     types.StringValue(onDestroyClear),
+}
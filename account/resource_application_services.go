@@ -7,19 +7,28 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ApplicationServiceMapping{}
 var _ resource.ResourceWithImportState = &ApplicationServiceMapping{}
+var _ resource.ResourceWithUpgradeState = &ApplicationServiceMapping{}
+var _ resource.ResourceWithModifyPlan = &ApplicationServiceMapping{}
 
 func NewApplicationServiceMapping() resource.Resource {
 	return &ApplicationServiceMapping{}
@@ -27,10 +36,27 @@ func NewApplicationServiceMapping() resource.Resource {
 
 // ApplicationServiceMapping defines the resource implementation.
 type ApplicationServiceMapping struct {
-	client accountservice.AccountService
+	client *accountClient
+}
+
+// serviceMappingFields maps Account API fields to attributes for validation errors.
+var serviceMappingFields = map[string]path.Path{
+	"scopes": path.Root("service"),
 }
 
 type ApplicationServiceMappingModel struct {
+	ApplicationID                  types.String   `tfsdk:"application_id"`
+	Preset                         types.String   `tfsdk:"preset"`
+	PresetServices                 []types.String `tfsdk:"preset_services"`
+	PolicyJSON                     types.String   `tfsdk:"policy_json"`
+	AcknowledgePrivilegeEscalation types.Bool     `tfsdk:"acknowledge_privilege_escalation"`
+	OnDestroy                      types.String   `tfsdk:"on_destroy"`
+	Services                       types.List     `tfsdk:"service"`
+	Timeouts                       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// applicationServiceMappingModelV0 describes version 0 of the resource data model.
+type applicationServiceMappingModelV0 struct {
 	ApplicationID types.String `tfsdk:"application_id"`
 	Services      types.List   `tfsdk:"service"`
 }
@@ -43,8 +69,48 @@ type ApplicationServiceScope struct {
 func (m ApplicationServiceScope) attributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
 		"name":  types.StringType,
-		"roles": types.ListType{types.StringType},
+		"roles": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// desiredScopes returns the scopes granted by the model. Its preset, policy
+// document and service blocks are applied in that order, each replacing the
+// roles of the last for the same service.
+func (r *ApplicationServiceMapping) desiredScopes(ctx context.Context, service accountservice.AccountService, d ApplicationServiceMappingModel) ([]accountservice.ApplicationServiceScope, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scopes := make([]ApplicationServiceScope, 0, len(d.Services.Elements()))
+	d.Services.ElementsAs(ctx, &scopes, false)
+
+	explicit := expandApplicationScope(ctx, scopes)
+
+	if d.Preset.IsNull() && d.PolicyJSON.IsNull() {
+		return explicit, nil
 	}
+
+	var base []accountservice.ApplicationServiceScope
+
+	if !d.Preset.IsNull() {
+		presetScopes, presetDiags := resolvePreset(service, r.client.presets, d.Preset.ValueString(), expandArray(ctx, d.PresetServices))
+		diags.Append(presetDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		base = presetScopes
+	}
+
+	if !d.PolicyJSON.IsNull() {
+		policyScopes, err := parsePolicyDocument(d.PolicyJSON.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("policy_json"), "Invalid Policy Document", err.Error())
+			return nil, diags
+		}
+
+		base = overrideScopes(base, policyScopes)
+	}
+
+	return overrideScopes(base, explicit), diags
 }
 
 func (r *ApplicationServiceMapping) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,13 +118,38 @@ func (r *ApplicationServiceMapping) Metadata(ctx context.Context, req resource.M
 }
 
 // Schema defines the schema for the resource.
-func (r *ApplicationServiceMapping) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ApplicationServiceMapping) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"application_id": schema.StringAttribute{
-				Required:    true,
-				Description: "ID of application to apply services access to",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "ID of application to apply services access to. Changing it moves the services to the new application, removing them from the old one.",
+			},
+			"preset": schema.StringAttribute{
+				Optional: true,
+				Description: "Named role preset to grant: read_only_all, full_access, or a preset declared in the provider block. " +
+					"policy_json and service blocks are applied after the preset, replacing its roles for the same service.",
+			},
+			"preset_services": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Services the full_access preset grants read and write roles on",
+			},
+			"policy_json": schema.StringAttribute{
+				Optional: true,
+				Description: "Policy document from account_application_policy_document. It is applied after the preset " +
+					"and before service blocks, each replacing the roles of the last for the same service.",
 			},
+			"acknowledge_privilege_escalation": schema.BoolAttribute{
+				Optional: true,
+				Description: "Allow a plan to grant roles the application does not already have when the provider " +
+					"sets error_on_privilege_escalation. Defaults to false",
+			},
+			"on_destroy": onDestroyAttribute("service roles"),
 		},
 		Blocks: map[string]schema.Block{
 			"service": schema.ListNestedBlock{
@@ -77,22 +168,80 @@ func (r *ApplicationServiceMapping) Schema(_ context.Context, _ resource.SchemaR
 					},
 				},
 			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 		Description: "Defines the services which the API key has access to and the access roles it has for each.",
 	}
 }
 
+// UpgradeState upgrades state written by prior schema versions.
+func (r *ApplicationServiceMapping) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 predates the timeouts block.
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"application_id": schema.StringAttribute{
+						Required: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"service": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Required: true,
+								},
+								"roles": schema.ListAttribute{
+									ElementType: types.StringType,
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior applicationServiceMappingModelV0
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := ApplicationServiceMappingModel{
+					ApplicationID:                  prior.ApplicationID,
+					Preset:                         types.StringNull(),
+					PolicyJSON:                     types.StringNull(),
+					AcknowledgePrivilegeEscalation: types.BoolNull(),
+					OnDestroy:                      types.StringValue(onDestroyClear),
+					Services:                       prior.Services,
+					Timeouts:                       nullTimeouts(),
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &upgraded)...)
+			},
+		},
+	}
+}
+
 func (r *ApplicationServiceMapping) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(accountservice.AccountService)
+	client, ok := req.ProviderData.(*accountClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected accountservice.AccountService, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -101,9 +250,132 @@ func (r *ApplicationServiceMapping) Configure(ctx context.Context, req resource.
 	r.client = client
 }
 
+// ModifyPlan reports roles a plan grants which the application does not
+// already have, so that escalations stand out from other changes to the
+// service list. Grants which are unknown until apply are not checked.
+func (r *ApplicationServiceMapping) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destroying the resource is already shown as such in the plan.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	for _, name := range []string{"preset", "preset_services", "policy_json", "service"} {
+		value, _, err := tftypes.WalkAttributePath(req.Plan.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Plan", err.Error())
+			return
+		}
+
+		if v, ok := value.(tftypes.Value); ok && !v.IsFullyKnown() {
+			tflog.Debug(ctx, "Planned services are unknown, skipping privilege escalation check")
+			return
+		}
+	}
+
+	var plan ApplicationServiceMappingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Planning reads the application's grants, so is bounded by the read timeout.
+	readTimeout, diags := plan.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
+	planned, diags := r.desiredScopes(ctx, service, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior, diags := r.priorScopes(ctx, service, req.State, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(privilegeEscalationDiagnostics(
+		plan.ApplicationID.ValueString(),
+		escalatedScopes(prior, planned),
+		r.client.errorOnPrivilegeEscalation && !plan.AcknowledgePrivilegeEscalation.ValueBool(),
+	)...)
+}
+
+// priorScopes returns the scopes the application in plan has before the plan
+// is applied: those in state when it manages the same application, otherwise
+// the application's current grants, or none when it does not exist yet.
+func (r *ApplicationServiceMapping) priorScopes(ctx context.Context, service accountservice.AccountService, state tfsdk.State, plan ApplicationServiceMappingModel) ([]accountservice.ApplicationServiceScope, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !state.Raw.IsNull() {
+		var d ApplicationServiceMappingModel
+
+		diags.Append(state.Get(ctx, &d)...)
+
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if plan.ApplicationID.Equal(d.ApplicationID) {
+			return r.desiredScopes(ctx, service, d)
+		}
+	}
+
+	if plan.ApplicationID.IsUnknown() {
+		return nil, diags
+	}
+
+	scopes, err := currentApplicationScopes(service, plan.ApplicationID.ValueString())
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Error Retrieving Application Services", err, serviceMappingFields)...)
+	}
+
+	return scopes, diags
+}
+
+// privilegeEscalationDiagnostics reports the roles in escalated as a warning,
+// or as an error when required is set.
+func privilegeEscalationDiagnostics(applicationID string, escalated []accountservice.ApplicationServiceScope, required bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(escalated) == 0 {
+		return diags
+	}
+
+	grants := make([]string, len(escalated))
+	for i, scope := range escalated {
+		grants[i] = fmt.Sprintf("  - %s: %s", scope.Service, strings.Join(scope.Roles, ", "))
+	}
+
+	detail := fmt.Sprintf("This plan grants application %s roles it does not currently have:\n\n%s",
+		applicationID, strings.Join(grants, "\n"))
+
+	if required {
+		diags.AddError("Application Privilege Escalation",
+			detail+"\n\nSet acknowledge_privilege_escalation = true on the resource to apply it.")
+		return diags
+	}
+
+	diags.AddWarning("Application Privilege Escalation", detail)
+
+	return diags
+}
+
 func (r *ApplicationServiceMapping) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var d ApplicationServiceMappingModel
-	service := r.client
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &d)...)
 
@@ -111,24 +383,53 @@ func (r *ApplicationServiceMapping) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	createTimeout, diags := d.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
+	unlock := r.client.lockApplication(d.ApplicationID.ValueString())
+	defer unlock()
+
+	original, err := service.GetApplicationServices(d.ApplicationID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Application Services", err, serviceMappingFields)...)
+		return
+	}
+
+	resp.Diagnostics.Append(setOriginal(ctx, resp.Private, original)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Info(ctx, "Setting API Application Services")
 
-	scopes := make([]ApplicationServiceScope, 0, len(d.Services.Elements()))
-	d.Services.ElementsAs(ctx, &scopes, false)
+	scopes, diags := r.desiredScopes(ctx, service, d)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	setServiceReq := accountservice.SetServiceRequest{
-		Scopes: expandApplicationScope(ctx, scopes),
+		Scopes: scopes,
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Created Set Service Request: %+v", setServiceReq))
 
-	err := service.SetApplicationServices(d.ApplicationID.ValueString(), setServiceReq)
+	err = service.SetApplicationServices(d.ApplicationID.ValueString(), setServiceReq)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Setting Application Services",
-			fmt.Sprint(err),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Setting Application Services", err, serviceMappingFields)...)
 		return
 	}
 
@@ -137,7 +438,6 @@ func (r *ApplicationServiceMapping) Create(ctx context.Context, req resource.Cre
 
 func (r *ApplicationServiceMapping) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var d ApplicationServiceMappingModel
-	service := r.client
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
 
@@ -145,6 +445,18 @@ func (r *ApplicationServiceMapping) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	readTimeout, diags := d.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
 	tflog.Info(ctx, "Retrieving API Application Services", map[string]interface{}{
 		"id": d.ApplicationID.ValueString(),
 	})
@@ -152,20 +464,21 @@ func (r *ApplicationServiceMapping) Read(ctx context.Context, req resource.ReadR
 	services, err := service.GetApplicationServices(d.ApplicationID.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Retrieving Application Services",
-			fmt.Sprint(err),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Application Services", err, serviceMappingFields)...)
+		return
+	}
+
+	expandedStateScopes, diags := r.desiredScopes(ctx, service, d)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	stateScopes := make([]ApplicationServiceScope, 0, len(d.Services.Elements()))
-	d.Services.ElementsAs(ctx, &stateScopes, false)
-	expandedStateScopes := expandApplicationScope(ctx, stateScopes)
 	readScopes := readApplicationScope(ctx, services.Scopes)
 
 	stateScopesJson, _ := json.Marshal(expandedStateScopes)
-	readScopesJson, _ := json.Marshal(services.Scopes)
+	readScopesJson, _ := json.Marshal(normalizeApplicationScope(services.Scopes))
 
 	if string(stateScopesJson) != string(readScopesJson) {
 		d.Services, _ = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ApplicationServiceScope{}.attributeTypes()}, readScopes)
@@ -175,29 +488,48 @@ func (r *ApplicationServiceMapping) Read(ctx context.Context, req resource.ReadR
 
 func (r *ApplicationServiceMapping) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, d ApplicationServiceMappingModel
-	service := r.client
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
+	unlock := r.client.lockApplication(plan.ApplicationID.ValueString())
+	defer unlock()
+
 	tflog.Info(ctx, "Setting API Application Services")
 
-	scopes := make([]ApplicationServiceScope, 0, len(plan.Services.Elements()))
-	plan.Services.ElementsAs(ctx, &scopes, false)
+	scopes, diags := r.desiredScopes(ctx, service, plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	setServiceReq := accountservice.SetServiceRequest{
-		Scopes: expandApplicationScope(ctx, scopes),
+		Scopes: scopes,
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Created Set Service Request: %+v", setServiceReq))
 
-	err := service.SetApplicationServices(d.ApplicationID.ValueString(), setServiceReq)
+	err := service.SetApplicationServices(plan.ApplicationID.ValueString(), setServiceReq)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Setting Application Services",
-			fmt.Sprint(err),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Setting Application Services", err, serviceMappingFields)...)
 		return
 	}
 
@@ -206,35 +538,114 @@ func (r *ApplicationServiceMapping) Update(ctx context.Context, req resource.Upd
 
 func (r *ApplicationServiceMapping) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var d ApplicationServiceMappingModel
-	service := r.client
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := d.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if d.OnDestroy.ValueString() == onDestroyAbandon {
+		tflog.Info(ctx, "Abandoning API Application Services")
+		return
+	}
+
+	service := r.client.Service(ctx)
+
+	unlock := r.client.lockApplication(d.ApplicationID.ValueString())
+	defer unlock()
+
 	tflog.Info(ctx, "Removing API Application Services")
 
 	_, err := service.GetApplication(d.ApplicationID.ValueString())
 
+	if isNotFoundError(err) {
+		tflog.Info(ctx, "Application not found, nothing to remove")
+		return
+	}
+
 	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving API Application", err, serviceMappingFields)...)
 		return
 	}
 
+	if d.OnDestroy.ValueString() == onDestroyRestore {
+		restored, diags := restoreApplicationServices(ctx, service, d.ApplicationID.ValueString(), req.Private)
+		resp.Diagnostics.Append(diags...)
+
+		if restored || resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Info(ctx, "Application found, removing services")
 
 	err = service.DeleteApplicationServices(d.ApplicationID.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Removing Application Services",
-			fmt.Sprint(err),
-		)
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Removing Application Services", err, serviceMappingFields)...)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
 }
 
+// restoreApplicationServices puts back the services recorded in private when
+// the resource was created or imported. It reports false when there are none
+// to restore, leaving the services to be cleared.
+func restoreApplicationServices(ctx context.Context, service accountservice.AccountService, appID string, private privateStateGetter) (bool, diag.Diagnostics) {
+	var original accountservice.ApplicationServiceMapping
+
+	found, diags := getOriginal(ctx, private, &original)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	if !found {
+		diags.AddWarning("Original Application Services Unknown",
+			fmt.Sprintf("No service roles were recorded for application %s when the resource was created or imported, "+
+				"so they have been cleared instead of restored.", appID))
+	}
+
+	if len(original.Scopes) == 0 {
+		return false, diags
+	}
+
+	tflog.Info(ctx, "Application found, restoring original services")
+
+	err := service.SetApplicationServices(appID, accountservice.SetServiceRequest{
+		Scopes: original.Scopes,
+	})
+
+	if err != nil {
+		diags.Append(apiErrorDiagnostics("Error Restoring Application Services", err, serviceMappingFields)...)
+	}
+
+	return true, diags
+}
+
 func (r *ApplicationServiceMapping) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("application_id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_destroy"), onDestroyClear)...)
+
+	// The services in place at import are those restored on destroy.
+	services, err := r.client.Service(ctx).GetApplicationServices(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Application Services", err, serviceMappingFields)...)
+		return
+	}
+
+	resp.Diagnostics.Append(setOriginal(ctx, resp.Private, services)...)
 }
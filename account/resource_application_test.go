@@ -1,13 +1,25 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 func TestAccApplication_basic(t *testing.T) {
@@ -30,10 +42,359 @@ func TestAccApplication_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", applicationDescription),
 				),
 			},
+			{
+				Config: providerConfig + testAccResourceApplicationConfig_noDescription(applicationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				Config:   providerConfig + testAccResourceApplicationConfig_noDescription(applicationName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccApplication_keyWriteOnly(t *testing.T) {
+	applicationName := acctest.RandomWithPrefix("tftest")
+	resourceName := "account_application.test-application"
+
+	service := AccTestingClient{}
+	service.Configure()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             service.testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccResourceApplicationConfig_keyWriteOnly(applicationName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					service.testAccCheckApplicationExists(t, resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "key"),
+					resource.TestCheckResourceAttr(resourceName, "key_wo_version", "1"),
+				),
+			},
+			{
+				Config: providerConfig + testAccResourceApplicationConfig_keyWriteOnly(applicationName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "key"),
+				),
+			},
 		},
 	})
 }
 
+func TestAccApplication_deletionProtection(t *testing.T) {
+	applicationName := acctest.RandomWithPrefix("tftest")
+	resourceName := "account_application.test-application"
+
+	service := AccTestingClient{}
+	service.Configure()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             service.testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccResourceApplicationConfig_deletionProtection(applicationName, 1, true),
+				Check: resource.ComposeTestCheckFunc(
+					service.testAccCheckApplicationExists(t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      providerConfig + testAccResourceApplicationConfig_deletionProtection(applicationName, 2, true),
+				ExpectError: regexp.MustCompile("Application Deletion Protection Enabled"),
+			},
+			{
+				Config:      providerConfig + testAccResourceApplicationConfig_deletionProtection(applicationName, 1, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Application Deletion Protection Enabled"),
+			},
+			{
+				Config: providerConfig + testAccResourceApplicationConfig_deletionProtection(applicationName, 1, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplication_namePrefix(t *testing.T) {
+	resourceName := "account_application.test-application"
+
+	service := AccTestingClient{}
+	service.Configure()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             service.testAccCheckApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccResourceApplicationConfig_namePrefix("tftest-", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					service.testAccCheckApplicationExists(t, resourceName),
+					resource.TestMatchResourceAttr(resourceName, "name", regexp.MustCompile(`^tftest-\d{14}[0-9a-f]{4}$`)),
+				),
+			},
+			{
+				Config: providerConfig + testAccResourceApplicationConfig_namePrefix("tftest-", "second"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("name"), knownvalue.StringRegexp(regexp.MustCompile(`^tftest-`))),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestUniqueName(t *testing.T) {
+	name := uniqueName("ci-")
+
+	if !regexp.MustCompile(`^ci-\d{14}[0-9a-f]{4}$`).MatchString(name) {
+		t.Errorf("unexpected name %q", name)
+	}
+}
+
+func TestAccountApplicationValidateConfig(t *testing.T) {
+	cases := map[string]struct {
+		name          types.String
+		namePrefix    types.String
+		adoptExisting types.Bool
+		err           string
+	}{
+		"name":    {name: types.StringValue("app"), namePrefix: types.StringNull()},
+		"prefix":  {name: types.StringNull(), namePrefix: types.StringValue("app-")},
+		"unknown": {name: types.StringUnknown(), namePrefix: types.StringValue("app-")},
+		"neither": {name: types.StringNull(), namePrefix: types.StringNull(), err: "Missing Application Name"},
+		"both":    {name: types.StringValue("app"), namePrefix: types.StringValue("app-"), err: "Conflicting Application Name"},
+		"adopt prefix": {
+			name:          types.StringNull(),
+			namePrefix:    types.StringValue("app-"),
+			adoptExisting: types.BoolValue(true),
+			err:           "Conflicting Application Adoption",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			req := fwresource.ValidateConfigRequest{
+				Config: testApplicationConfig(t, AccountApplicationModel{Name: c.name, NamePrefix: c.namePrefix, AdoptExisting: c.adoptExisting}),
+			}
+			resp := fwresource.ValidateConfigResponse{}
+
+			(&AccountApplication{}).ValidateConfig(context.Background(), req, &resp)
+
+			if len(c.err) == 0 {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Summary(), c.err) {
+				t.Errorf("expected %q error, got %v", c.err, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestFindApplicationsByName(t *testing.T) {
+	service := testAccountService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/account/v1/applications" || r.URL.Query().Get("name:eq") != "ci" {
+			t.Errorf("unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+
+		_, _ = w.Write([]byte(`{"data":[{"id":"app-1","name":"ci"},{"id":"app-2","name":"ci-old"}],"meta":{"pagination":{"total_pages":1}}}`))
+	})
+
+	matches, err := findApplicationsByName(service, "ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(matches) != 1 || matches[0].ID != "app-1" {
+		t.Errorf("expected only app-1, got %+v", matches)
+	}
+}
+
+func TestAccountApplication_adoptApplication(t *testing.T) {
+	var body map[string]interface{}
+
+	client := testAccountClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/account/v1/applications/app-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	d := AccountApplicationModel{
+		Name:        types.StringValue("ci"),
+		Description: types.StringValue("adopted"),
+		Key:         types.StringUnknown(),
+	}
+
+	diags := (&AccountApplication{client: client}).adoptApplication(context.Background(), &d, accountservice.Application{ID: "app-1", Name: "ci"})
+
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+
+	if d.ID.ValueString() != "app-1" || !d.Key.IsNull() {
+		t.Errorf("expected app-1 with a null key, got %+v", d)
+	}
+
+	if body["description"] != "adopted" {
+		t.Errorf("expected description to be updated, got %v", body)
+	}
+}
+
+// TestAccountApplication_createUnconfirmed times out a first apply after the
+// API has created the application, while it cannot be looked up, and checks the
+// next refresh finds that application rather than another being created.
+func TestAccountApplication_createUnconfirmed(t *testing.T) {
+	api := &testApplicationAPI{
+		applications:   []accountservice.Application{{ID: "app-0", Name: "ci", Description: "portal key"}},
+		outageOnCreate: true,
+	}
+	client := testAccountClient(t, api.handler(t))
+	r := &AccountApplication{client: client}
+	private := testPrivateState{}
+
+	// The first apply's create is stored by the API, which then stops
+	// responding, so the create times out and cannot be looked up.
+	d := AccountApplicationModel{
+		Name:        types.StringValue("ci"),
+		Description: types.StringValue("deploys"),
+		Key:         types.StringUnknown(),
+		ID:          types.StringUnknown(),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	diags := r.createApplication(ctx, &d, private)
+
+	if diags.HasError() || diags.WarningsCount() != 1 || diags[0].Summary() != "API Application Create Not Confirmed" {
+		t.Fatalf("expected the create to be reported as not confirmed, got %v", diags)
+	}
+
+	if !d.ID.IsNull() || len(private[pendingCreatePrivateKey]) == 0 {
+		t.Fatalf("expected a null ID with the create kept pending, got %+v", d)
+	}
+
+	// The next apply refreshes the application once the API has recovered.
+	api.mu.Lock()
+	api.outageOnCreate = false
+	api.listStatus = 0
+	api.mu.Unlock()
+
+	found, diags := findPendingApplication(context.Background(), client.Service(context.Background()), &d, private)
+	if diags.HasError() || !found {
+		t.Fatalf("expected the pending application to be found, got found=%t %v", found, diags)
+	}
+
+	if d.ID.ValueString() != "app-1" {
+		t.Errorf("expected app-1, created by the first apply, got %s", d.ID)
+	}
+
+	if api.creates != 1 || len(api.applications) != 2 {
+		t.Errorf("expected the first apply's application to be reused, got %d creates: %+v", api.creates, api.applications)
+	}
+
+	if api.applications[1].Description != "deploys" {
+		t.Errorf("expected the description to be set by the create, got %q", api.applications[1].Description)
+	}
+}
+
+func TestAccountApplication_UpdateKey(t *testing.T) {
+	cases := map[string]struct {
+		key      string
+		state    AccountApplicationModel
+		plan     AccountApplicationModel
+		expected types.String
+		warning  bool
+	}{
+		"key_wo_version removed": {
+			key:      "key-1",
+			state:    AccountApplicationModel{Key: types.StringNull(), KeyWOVersion: types.Int64Value(1)},
+			plan:     AccountApplicationModel{Key: types.StringUnknown(), KeyWOVersion: types.Int64Null()},
+			expected: types.StringValue("key-1"),
+		},
+		"key_wo_version removed without a key returned": {
+			state:    AccountApplicationModel{Key: types.StringNull(), KeyWOVersion: types.Int64Value(1)},
+			plan:     AccountApplicationModel{Key: types.StringUnknown(), KeyWOVersion: types.Int64Null(), Description: types.StringValue("updated")},
+			expected: types.StringNull(),
+			warning:  true,
+		},
+		"key_wo_version set": {
+			key:      "key-1",
+			state:    AccountApplicationModel{Key: types.StringValue("key-1")},
+			plan:     AccountApplicationModel{Key: types.StringNull(), KeyWOVersion: types.Int64Value(1)},
+			expected: types.StringNull(),
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			api := &testApplicationAPI{applications: []accountservice.Application{
+				{ID: "app-1", Key: c.key, Name: "ci", Description: "deploys"},
+			}}
+			r := &AccountApplication{client: testAccountClient(t, api.handler(t))}
+
+			for _, d := range []*AccountApplicationModel{&c.state, &c.plan} {
+				d.ID = types.StringValue("app-1")
+				d.Name = types.StringValue("ci")
+				d.DeletionProtection = types.BoolValue(false)
+				if d.Description.IsNull() {
+					d.Description = types.StringValue("deploys")
+				}
+			}
+
+			plan := testApplicationState(t, c.plan)
+			req := fwresource.UpdateRequest{
+				State: testApplicationState(t, c.state),
+				Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+			}
+			resp := fwresource.UpdateResponse{State: plan}
+
+			r.Update(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() || (resp.Diagnostics.WarningsCount() > 0) != c.warning {
+				t.Fatalf("expected warning=%t, got %v", c.warning, resp.Diagnostics)
+			}
+
+			var updated AccountApplicationModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &updated)...)
+
+			if !updated.Key.Equal(c.expected) {
+				t.Errorf("expected key %s, got %s", c.expected, updated.Key)
+			}
+
+			if updated.Description.ValueString() != c.plan.Description.ValueString() {
+				t.Errorf("expected description %q, got %q", c.plan.Description.ValueString(), updated.Description.ValueString())
+			}
+		})
+	}
+}
+
 func (r *AccTestingClient) testAccCheckApplicationExists(t *testing.T, n string) resource.TestCheckFunc {
 	service := r.client
 	return func(s *terraform.State) error {
@@ -98,3 +459,104 @@ func testAccResourceApplicationConfig_basic(applicationName string, applicationD
 		`, applicationName, applicationDesription,
 	)
 }
+
+func testAccResourceApplicationConfig_keyWriteOnly(applicationName string, keyVersion int) string {
+	return fmt.Sprintf(`
+		resource "account_application" "test-application"{
+			name = "%[1]s"
+			key_wo_version = %[2]d
+		}
+		`, applicationName, keyVersion,
+	)
+}
+
+func testAccResourceApplicationConfig_deletionProtection(applicationName string, keyVersion int, deletionProtection bool) string {
+	return fmt.Sprintf(`
+		resource "account_application" "test-application"{
+			name = "%[1]s"
+			key_wo_version = %[2]d
+			deletion_protection = %[3]t
+		}
+		`, applicationName, keyVersion, deletionProtection,
+	)
+}
+
+func testAccResourceApplicationConfig_namePrefix(namePrefix string, description string) string {
+	return fmt.Sprintf(`
+		resource "account_application" "test-application"{
+			name_prefix = "%[1]s"
+			description = "%[2]s"
+
+			lifecycle {
+				create_before_destroy = true
+			}
+		}
+		`, namePrefix, description,
+	)
+}
+
+func testAccResourceApplicationConfig_noDescription(applicationName string) string {
+	return fmt.Sprintf(`
+		resource "account_application" "test-application"{
+			name = "%[1]s"
+		}
+		`, applicationName,
+	)
+}
+
+func TestAccountApplication_ModifyPlanDeletionProtection(t *testing.T) {
+	cases := map[string]struct {
+		state AccountApplicationModel
+		plan  AccountApplicationModel
+		err   bool
+	}{
+		"replaced": {
+			state: AccountApplicationModel{KeyWOVersion: types.Int64Value(1), DeletionProtection: types.BoolValue(true)},
+			plan:  AccountApplicationModel{KeyWOVersion: types.Int64Value(2), DeletionProtection: types.BoolValue(true)},
+			err:   true,
+		},
+		"replaced without protection": {
+			state: AccountApplicationModel{KeyWOVersion: types.Int64Value(1), DeletionProtection: types.BoolValue(false)},
+			plan:  AccountApplicationModel{KeyWOVersion: types.Int64Value(2), DeletionProtection: types.BoolValue(false)},
+		},
+		"name_prefix changed": {
+			state: AccountApplicationModel{NamePrefix: types.StringValue("ci-"), DeletionProtection: types.BoolValue(true)},
+			plan:  AccountApplicationModel{NamePrefix: types.StringValue("deploy-"), DeletionProtection: types.BoolValue(true)},
+			err:   true,
+		},
+		"name_prefix unknown": {
+			state: AccountApplicationModel{NamePrefix: types.StringValue("ci-"), DeletionProtection: types.BoolValue(true)},
+			plan:  AccountApplicationModel{NamePrefix: types.StringUnknown(), DeletionProtection: types.BoolValue(true)},
+			err:   true,
+		},
+		"updated": {
+			state: AccountApplicationModel{KeyWOVersion: types.Int64Value(1), DeletionProtection: types.BoolValue(true)},
+			plan:  AccountApplicationModel{KeyWOVersion: types.Int64Value(1), DeletionProtection: types.BoolValue(true), Description: types.StringValue("updated")},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			for _, d := range []*AccountApplicationModel{&c.state, &c.plan} {
+				d.ID = types.StringValue("app-1")
+				d.Name = types.StringValue("ci")
+				if d.Description.IsNull() {
+					d.Description = types.StringValue("")
+				}
+			}
+
+			plan := testApplicationState(t, c.plan)
+			req := fwresource.ModifyPlanRequest{
+				State: testApplicationState(t, c.state),
+				Plan:  tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+			}
+			resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+
+			(&AccountApplication{}).ModifyPlan(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() != c.err {
+				t.Errorf("expected error=%t, got %v", c.err, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,399 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ResellerClient{}
+var _ resource.ResourceWithImportState = &ResellerClient{}
+
+func NewResellerClient() resource.Resource {
+	return &ResellerClient{}
+}
+
+// ResellerClient defines the resource implementation.
+type ResellerClient struct {
+	client *accountClient
+}
+
+// resellerClientFields maps Account API fields to attributes for validation errors.
+var resellerClientFields = func() map[string]path.Path {
+	fields := map[string]path.Path{}
+	for name := range clientAttributeDescriptions {
+		fields[name] = path.Root(name)
+	}
+	return fields
+}()
+
+// ResellerClientModel describes the resource data model.
+type ResellerClientModel struct {
+	ID               types.Int64    `tfsdk:"id"`
+	CompanyName      types.String   `tfsdk:"company_name"`
+	FirstName        types.String   `tfsdk:"first_name"`
+	LastName         types.String   `tfsdk:"last_name"`
+	EmailAddress     types.String   `tfsdk:"email_address"`
+	LimitedNumber    types.String   `tfsdk:"limited_number"`
+	VATNumber        types.String   `tfsdk:"vat_number"`
+	Address          types.String   `tfsdk:"address"`
+	Address1         types.String   `tfsdk:"address1"`
+	City             types.String   `tfsdk:"city"`
+	County           types.String   `tfsdk:"county"`
+	Country          types.String   `tfsdk:"country"`
+	Postcode         types.String   `tfsdk:"postcode"`
+	Phone            types.String   `tfsdk:"phone"`
+	Fax              types.String   `tfsdk:"fax"`
+	Mobile           types.String   `tfsdk:"mobile"`
+	Type             types.String   `tfsdk:"type"`
+	UserName         types.String   `tfsdk:"user_name"`
+	IDReference      types.String   `tfsdk:"id_reference"`
+	NominetContactID types.String   `tfsdk:"nominet_contact_id"`
+	CreatedDate      types.String   `tfsdk:"created_date"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ResellerClient) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client"
+}
+
+// Schema defines the schema for the resource.
+func (r *ResellerClient) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+			Description: "ID of the client",
+		},
+		"created_date": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Description: "Date the client was created",
+		},
+	}
+
+	// The API cannot clear a client field once set, so fields left out of
+	// configuration keep their current value rather than planning a change.
+	// PatchClient omits empty strings, so they are rejected rather than
+	// planned and then left unchanged.
+	for name, description := range clientAttributeDescriptions {
+		attributes[name] = schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			Description: description,
+		}
+	}
+
+	attributes["company_name"] = schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		Description: clientAttributeDescriptions["company_name"],
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+		Description: "Manages a client account of a reseller.",
+	}
+}
+
+func (r *ResellerClient) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*accountClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ResellerClient) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var d ResellerClientModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := d.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
+	createReq := accountservice.CreateClientRequest{
+		CompanyName:      d.CompanyName.ValueString(),
+		FirstName:        d.FirstName.ValueString(),
+		LastName:         d.LastName.ValueString(),
+		EmailAddress:     d.EmailAddress.ValueString(),
+		LimitedNumber:    d.LimitedNumber.ValueString(),
+		VATNumber:        d.VATNumber.ValueString(),
+		Address:          d.Address.ValueString(),
+		Address1:         d.Address1.ValueString(),
+		City:             d.City.ValueString(),
+		County:           d.County.ValueString(),
+		Country:          d.Country.ValueString(),
+		Postcode:         d.Postcode.ValueString(),
+		Phone:            d.Phone.ValueString(),
+		Fax:              d.Fax.ValueString(),
+		Mobile:           d.Mobile.ValueString(),
+		Type:             d.Type.ValueString(),
+		UserName:         d.UserName.ValueString(),
+		IDReference:      d.IDReference.ValueString(),
+		NominetContactID: d.NominetContactID.ValueString(),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Created CreateClientRequest: %+v", createReq))
+
+	tflog.Info(ctx, "Creating Client")
+	id, err := service.CreateClient(createReq)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating Client", err, resellerClientFields)...)
+		return
+	}
+
+	client, err := service.GetClient(id)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Client", err, resellerClientFields)...)
+		return
+	}
+
+	d.setClient(client)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
+}
+
+func (r *ResellerClient) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var d ResellerClientModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := d.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
+	tflog.Info(ctx, "Retrieving Client", map[string]interface{}{
+		"id": d.ID.ValueInt64(),
+	})
+
+	client, err := service.GetClient(int(d.ID.ValueInt64()))
+
+	if isNotFoundError(err) {
+		tflog.Info(ctx, "Client not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Client", err, resellerClientFields)...)
+		return
+	}
+
+	d.setClient(client)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
+}
+
+func (r *ResellerClient) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, d ResellerClientModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
+	patchReq := accountservice.PatchClientRequest{
+		CompanyName:      plan.CompanyName.ValueString(),
+		FirstName:        plan.FirstName.ValueString(),
+		LastName:         plan.LastName.ValueString(),
+		EmailAddress:     plan.EmailAddress.ValueString(),
+		LimitedNumber:    plan.LimitedNumber.ValueString(),
+		VATNumber:        plan.VATNumber.ValueString(),
+		Address:          plan.Address.ValueString(),
+		Address1:         plan.Address1.ValueString(),
+		City:             plan.City.ValueString(),
+		County:           plan.County.ValueString(),
+		Country:          plan.Country.ValueString(),
+		Postcode:         plan.Postcode.ValueString(),
+		Phone:            plan.Phone.ValueString(),
+		Fax:              plan.Fax.ValueString(),
+		Mobile:           plan.Mobile.ValueString(),
+		Type:             plan.Type.ValueString(),
+		UserName:         plan.UserName.ValueString(),
+		IDReference:      plan.IDReference.ValueString(),
+		NominetContactID: plan.NominetContactID.ValueString(),
+	}
+
+	tflog.Info(ctx, "Updating Client", map[string]interface{}{
+		"id": d.ID.ValueInt64(),
+	})
+
+	id := int(d.ID.ValueInt64())
+
+	err := service.PatchClient(id, patchReq)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Updating Client", err, resellerClientFields)...)
+		return
+	}
+
+	client, err := service.GetClient(id)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Client", err, resellerClientFields)...)
+		return
+	}
+
+	plan.setClient(client)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ResellerClient) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var d ResellerClientModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := d.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
+	tflog.Info(ctx, "Removing Client", map[string]interface{}{
+		"id": d.ID.ValueInt64(),
+	})
+
+	err := service.DeleteClient(int(d.ID.ValueInt64()))
+
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Deleting Client", err, resellerClientFields)...)
+		return
+	}
+}
+
+func (r *ResellerClient) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Client ID",
+			fmt.Sprintf("Expected a numeric client ID, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
+
+// setClient updates the model with the client as stored by the API.
+func (m *ResellerClientModel) setClient(client accountservice.Client) {
+	c := readClient(client)
+
+	m.ID = c.ID
+	m.CompanyName = c.CompanyName
+	m.FirstName = c.FirstName
+	m.LastName = c.LastName
+	m.EmailAddress = c.EmailAddress
+	m.LimitedNumber = c.LimitedNumber
+	m.VATNumber = c.VATNumber
+	m.Address = c.Address
+	m.Address1 = c.Address1
+	m.City = c.City
+	m.County = c.County
+	m.Country = c.Country
+	m.Postcode = c.Postcode
+	m.Phone = c.Phone
+	m.Fax = c.Fax
+	m.Mobile = c.Mobile
+	m.Type = c.Type
+	m.UserName = c.UserName
+	m.IDReference = c.IDReference
+	m.NominetContactID = c.NominetContactID
+	m.CreatedDate = c.CreatedDate
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccClient_basic(t *testing.T) {
+	companyName := acctest.RandomWithPrefix("tftest")
+	resourceName := "account_client.test-client"
+	dataSourceName := "data.account_client.test-client"
+
+	service := AccTestingClient{}
+	service.Configure()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             service.testAccCheckClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccResourceClientConfig_basic(companyName, "Manchester"),
+				Check: resource.ComposeTestCheckFunc(
+					service.testAccCheckClientExists(t, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "company_name", companyName),
+					resource.TestCheckResourceAttr(resourceName, "city", "Manchester"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "company_name", resourceName, "company_name"),
+				),
+			},
+			{
+				Config: providerConfig + testAccResourceClientConfig_basic(companyName, "London"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "city", "London"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func (r *AccTestingClient) testAccCheckClientExists(t *testing.T, n string) resource.TestCheckFunc {
+	service := r.client
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			t.Fatalf("Not found: %s", n)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			t.Fatalf("Invalid Client ID: %s", rs.Primary.ID)
+		}
+
+		_, err = service.GetClient(id)
+		if err != nil {
+			if _, ok := err.(*accountservice.ClientNotFoundError); ok {
+				t.Fatal("Client Not found")
+			}
+			t.Fatal(err)
+		}
+
+		return nil
+	}
+}
+
+func (r *AccTestingClient) testAccCheckClientDestroy(s *terraform.State) error {
+	service := r.client
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "account_client" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = service.GetClient(id)
+		if err == nil {
+			return fmt.Errorf("Client with ID [%s] still exists", rs.Primary.ID)
+		}
+
+		if _, ok := err.(*accountservice.ClientNotFoundError); !ok {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceClientConfig_basic(companyName string, city string) string {
+	return fmt.Sprintf(`
+		resource "account_client" "test-client" {
+			company_name  = "%[1]s"
+			first_name    = "Test"
+			last_name     = "Client"
+			email_address = "tftest@example.com"
+			city          = "%[2]s"
+			country       = "United Kingdom"
+		}
+
+		data "account_client" "test-client" {
+			id = account_client.test-client.id
+		}
+		`, companyName, city,
+	)
+}
+
+func TestResellerClientSchema_emptyStrings(t *testing.T) {
+	ctx := context.Background()
+
+	var resp fwresource.SchemaResponse
+	NewResellerClient().Schema(ctx, fwresource.SchemaRequest{}, &resp)
+
+	for name := range clientAttributeDescriptions {
+		t.Run(name, func(t *testing.T) {
+			attribute, ok := resp.Schema.Attributes[name].(schema.StringAttribute)
+			if !ok {
+				t.Fatalf("expected string attribute %s", name)
+			}
+
+			validatorResp := &validator.StringResponse{}
+			for _, v := range attribute.StringValidators() {
+				v.ValidateString(ctx, validator.StringRequest{
+					Path:        path.Root(name),
+					ConfigValue: types.StringValue(""),
+				}, validatorResp)
+			}
+
+			if !validatorResp.Diagnostics.HasError() {
+				t.Errorf("expected an empty %s to be rejected, as PatchClient cannot send it", name)
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &InvoiceQuery{}
+var _ resource.ResourceWithImportState = &InvoiceQuery{}
+
+func NewInvoiceQuery() resource.Resource {
+	return &InvoiceQuery{}
+}
+
+// InvoiceQuery defines the resource implementation.
+type InvoiceQuery struct {
+	client *accountClient
+}
+
+// invoiceQueryFields maps Account API fields to attributes for validation errors.
+var invoiceQueryFields = map[string]path.Path{
+	"contact_id":        path.Root("contact_id"),
+	"contact_method":    path.Root("contact_method"),
+	"amount":            path.Root("amount"),
+	"what_was_expected": path.Root("what_was_expected"),
+	"what_was_received": path.Root("what_was_received"),
+	"proposed_solution": path.Root("proposed_solution"),
+	"invoice_ids":       path.Root("invoice_ids"),
+}
+
+// InvoiceQueryModel describes the resource data model.
+type InvoiceQueryModel struct {
+	ID               types.Int64    `tfsdk:"id"`
+	ContactID        types.Int64    `tfsdk:"contact_id"`
+	ContactMethod    types.String   `tfsdk:"contact_method"`
+	Amount           types.Float64  `tfsdk:"amount"`
+	WhatWasExpected  types.String   `tfsdk:"what_was_expected"`
+	WhatWasReceived  types.String   `tfsdk:"what_was_received"`
+	ProposedSolution types.String   `tfsdk:"proposed_solution"`
+	InvoiceIDs       []types.Int64  `tfsdk:"invoice_ids"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *InvoiceQuery) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_invoice_query"
+}
+
+// Schema defines the schema for the resource.
+func (r *InvoiceQuery) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Description: "ID of the invoice query",
+			},
+			"contact_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Description: "ID of the contact raising the query",
+			},
+			"contact_method": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						contactMethodChanged,
+						"Changing contact_method raises a new query, unless it is unset after import.",
+						"Changing `contact_method` raises a new query, unless it is unset after import.",
+					),
+				},
+				Description: "Preferred method of contact about the query",
+			},
+			"amount": schema.Float64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+				Description: "Amount being queried",
+			},
+			"what_was_expected": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "What was expected to be charged",
+			},
+			"what_was_received": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "What was charged, and the reason for the query",
+			},
+			"proposed_solution": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Description: "Proposed resolution of the query",
+			},
+			"invoice_ids": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Required:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Description: "IDs of the invoices being queried",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+			}),
+		},
+		Description: "Raises a query against one or more invoices. Invoice queries cannot be changed or withdrawn through the API, " +
+			"so any change raises a new query and destroying the resource only removes it from state.",
+	}
+}
+
+func (r *InvoiceQuery) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*accountClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *accountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InvoiceQuery) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var d InvoiceQueryModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := d.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
+	invoiceIDs := make([]int, len(d.InvoiceIDs))
+	for i, v := range d.InvoiceIDs {
+		invoiceIDs[i] = int(v.ValueInt64())
+	}
+
+	createReq := accountservice.CreateInvoiceQueryRequest{
+		ContactID:        int(d.ContactID.ValueInt64()),
+		ContactMethod:    d.ContactMethod.ValueString(),
+		Amount:           float32(d.Amount.ValueFloat64()),
+		WhatWasExpected:  d.WhatWasExpected.ValueString(),
+		WhatWasReceived:  d.WhatWasReceived.ValueString(),
+		ProposedSolution: d.ProposedSolution.ValueString(),
+		InvoiceIDs:       invoiceIDs,
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Created CreateInvoiceQueryRequest: %+v", createReq))
+
+	tflog.Info(ctx, "Creating Invoice Query")
+	id, err := service.CreateInvoiceQuery(createReq)
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Creating Invoice Query", err, invoiceQueryFields)...)
+		return
+	}
+
+	d.ID = types.Int64Value(int64(id))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
+}
+
+func (r *InvoiceQuery) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var d InvoiceQueryModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := d.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	service := r.client.Service(ctx)
+
+	tflog.Info(ctx, "Retrieving Invoice Query", map[string]interface{}{
+		"id": d.ID.ValueInt64(),
+	})
+
+	query, err := service.GetInvoiceQuery(int(d.ID.ValueInt64()))
+
+	if isNotFoundError(err) {
+		tflog.Info(ctx, "Invoice Query not found, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error Retrieving Invoice Query", err, invoiceQueryFields)...)
+		return
+	}
+
+	d.ContactID = types.Int64Value(int64(query.ContactID))
+	d.Amount = readApiFloat(query.Amount)
+	d.WhatWasExpected = types.StringValue(query.WhatWasExpected)
+	d.WhatWasReceived = types.StringValue(query.WhatWasReceived)
+	d.ProposedSolution = types.StringValue(query.ProposedSolution)
+	d.InvoiceIDs = make([]types.Int64, len(query.InvoiceIDs))
+	for i, v := range query.InvoiceIDs {
+		d.InvoiceIDs[i] = types.Int64Value(int64(v))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
+}
+
+// Update only records the configured contact_method after import, as every
+// other change requires replacement.
+func (r *InvoiceQuery) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var d InvoiceQueryModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &d)...)
+}
+
+func (r *InvoiceQuery) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var d InvoiceQueryModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &d)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removing Invoice Query from state", map[string]interface{}{
+		"id": d.ID.ValueInt64(),
+	})
+
+	resp.Diagnostics.AddWarning(
+		"Invoice Query Not Withdrawn",
+		fmt.Sprintf("Invoice query %d has been removed from state. Invoice queries cannot be withdrawn through the API, "+
+			"so it remains open.", d.ID.ValueInt64()),
+	)
+}
+
+func (r *InvoiceQuery) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.Atoi(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Invoice Query ID",
+			fmt.Sprintf("Expected a numeric invoice query ID, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(id))...)
+}
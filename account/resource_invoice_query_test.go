@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccInvoiceQuery_basic raises a real invoice query, which cannot be withdrawn,
+// so it only runs when ACCOUNT_TEST_INVOICE_ID names an invoice to query.
+func TestAccInvoiceQuery_basic(t *testing.T) {
+	invoiceID := os.Getenv("ACCOUNT_TEST_INVOICE_ID")
+	if invoiceID == "" {
+		t.Skip("ACCOUNT_TEST_INVOICE_ID must be set to raise a test invoice query")
+	}
+
+	resourceName := "account_invoice_query.test-invoice-query"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccResourceInvoiceQueryConfig_basic(invoiceID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "amount", "1.5"),
+					resource.TestCheckResourceAttr(resourceName, "invoice_ids.0", invoiceID),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceInvoiceQueryConfig_basic(invoiceID string) string {
+	return fmt.Sprintf(`
+		data "account_details" "test-details" {}
+
+		resource "account_invoice_query" "test-invoice-query" {
+			contact_id        = data.account_details.test-details.primary_contact_id
+			contact_method    = "email"
+			amount            = 1.5
+			what_was_expected = "tftest: expected charge"
+			what_was_received = "tftest: received charge"
+			proposed_solution = "tftest: no action required"
+			invoice_ids       = [%s]
+		}
+		`, invoiceID,
+	)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// testUpgradeStateV0 upgrades the version 0 state fixture for typeName through
+// the provider server, as Terraform does when loading old state.
+func testUpgradeStateV0(t *testing.T, r resource.Resource, typeName string) tfsdk.State {
+	return testUpgradeStateV0Fixture(t, r, typeName, typeName)
+}
+
+// testUpgradeStateV0Fixture upgrades the named version 0 state fixture as
+// state for typeName.
+func testUpgradeStateV0Fixture(t *testing.T, r resource.Resource, typeName string, name string) tfsdk.State {
+	ctx := context.Background()
+
+	fixture, err := os.ReadFile(filepath.Join("testdata", "state_v0", name+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := providerserver.NewProtocol6(New("test")())()
+	upgradeResp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  0,
+		RawState: &tfprotov6.RawState{JSON: fixture},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, d := range upgradeResp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	if t.Failed() {
+		t.FailNow()
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	raw, err := upgradeResp.UpgradedState.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    raw,
+	}
+}
+
+func TestAccountApplication_UpgradeStateV0(t *testing.T) {
+	var d AccountApplicationModel
+
+	state := testUpgradeStateV0(t, NewAccountApplication(), "account_application")
+	if diags := state.Get(context.Background(), &d); diags.HasError() {
+		t.Fatalf("unexpected error reading upgraded state: %v", diags)
+	}
+
+	if d.ID.ValueString() != "d8a7c3f0-1b2e-4c5d-9e6f-7a8b9c0d1e2f" {
+		t.Errorf("unexpected id %s", d.ID)
+	}
+
+	if d.Key.ValueString() != "abcdef0123456789" {
+		t.Errorf("unexpected key %s", d.Key)
+	}
+
+	if d.Name.ValueString() != "tftest-application" {
+		t.Errorf("unexpected name %s", d.Name)
+	}
+
+	if d.Description.ValueString() != "test" {
+		t.Errorf("unexpected description %s", d.Description)
+	}
+
+	if !d.DeletionProtection.Equal(types.BoolValue(false)) {
+		t.Errorf("expected deletion_protection false, got %s", d.DeletionProtection)
+	}
+
+	if !d.KeyWOVersion.IsNull() || !d.NamePrefix.IsNull() || !d.AdoptExisting.IsNull() {
+		t.Errorf("expected null key_wo_version, name_prefix and adopt_existing, got %+v", d)
+	}
+
+	if !d.Timeouts.IsNull() {
+		t.Errorf("expected null timeouts, got %s", d.Timeouts)
+	}
+}
+
+func TestAccountApplication_UpgradeStateV0NoDescription(t *testing.T) {
+	var d AccountApplicationModel
+
+	state := testUpgradeStateV0Fixture(t, NewAccountApplication(), "account_application", "account_application_no_description")
+	if diags := state.Get(context.Background(), &d); diags.HasError() {
+		t.Fatalf("unexpected error reading upgraded state: %v", diags)
+	}
+
+	if !d.Description.Equal(types.StringValue("")) {
+		t.Errorf("expected empty description, got %s", d.Description)
+	}
+}
+
+func TestApplicationServiceMapping_UpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	var d ApplicationServiceMappingModel
+
+	state := testUpgradeStateV0(t, NewApplicationServiceMapping(), "account_application_services")
+	if diags := state.Get(ctx, &d); diags.HasError() {
+		t.Fatalf("unexpected error reading upgraded state: %v", diags)
+	}
+
+	if d.ApplicationID.ValueString() != "d8a7c3f0-1b2e-4c5d-9e6f-7a8b9c0d1e2f" {
+		t.Errorf("unexpected application_id %s", d.ApplicationID)
+	}
+
+	scopes := make([]ApplicationServiceScope, 0, len(d.Services.Elements()))
+	if diags := d.Services.ElementsAs(ctx, &scopes, false); diags.HasError() {
+		t.Fatalf("unexpected error reading services: %v", diags)
+	}
+
+	if len(scopes) != 1 || scopes[0].Name.ValueString() != "ecloud" || len(scopes[0].Roles) != 2 {
+		t.Errorf("unexpected services %+v", scopes)
+	}
+
+	if !d.OnDestroy.Equal(types.StringValue(onDestroyClear)) {
+		t.Errorf("expected on_destroy clear, got %s", d.OnDestroy)
+	}
+
+	if !d.Preset.IsNull() || !d.PolicyJSON.IsNull() || !d.AcknowledgePrivilegeEscalation.IsNull() {
+		t.Errorf("expected null preset, policy_json and acknowledge_privilege_escalation, got %+v", d)
+	}
+
+	if !d.Timeouts.IsNull() {
+		t.Errorf("expected null timeouts, got %s", d.Timeouts)
+	}
+}
+
+func TestApplicationIPRestriction_UpgradeStateV0(t *testing.T) {
+	var d ApplicationIPRestrictionModel
+
+	state := testUpgradeStateV0(t, NewApplicationIPRestriction(), "account_application_restriction")
+	if diags := state.Get(context.Background(), &d); diags.HasError() {
+		t.Fatalf("unexpected error reading upgraded state: %v", diags)
+	}
+
+	if d.ApplicationID.ValueString() != "d8a7c3f0-1b2e-4c5d-9e6f-7a8b9c0d1e2f" {
+		t.Errorf("unexpected application_id %s", d.ApplicationID)
+	}
+
+	if d.Type.ValueString() != "allowlist" {
+		t.Errorf("unexpected type %s", d.Type)
+	}
+
+	if len(d.Ranges) != 1 || d.Ranges[0].ValueString() != "1.1.1.1/24" {
+		t.Errorf("unexpected ranges %v", d.Ranges)
+	}
+
+	if !d.OnDestroy.Equal(types.StringValue(onDestroyClear)) {
+		t.Errorf("expected on_destroy clear, got %s", d.OnDestroy)
+	}
+
+	if !d.Timeouts.IsNull() {
+		t.Errorf("expected null timeouts, got %s", d.Timeouts)
+	}
+}
@@ -2,10 +2,16 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/netip"
+	"reflect"
 	"sort"
+	"strings"
 
 	"github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -16,26 +22,317 @@ func expandApplicationScope(ctx context.Context, rawAppScope []ApplicationServic
 	tflog.Info(ctx, fmt.Sprintf("expandApplicationScope Raw Application Service Scope: %+v", rawAppScope))
 
 	for i := range rawAppScope {
-		rolesArray := expandArray(ctx, rawAppScope[i].Roles)
-		sort.Slice(rolesArray, func(j, k int) bool {
-			return rolesArray[j] < rolesArray[k]
-		})
-
 		appScope[i] = account.ApplicationServiceScope{
 			Service: rawAppScope[i].Name.ValueString(),
-			Roles:   rolesArray,
+			Roles:   expandArray(ctx, rawAppScope[i].Roles),
 		}
 	}
 
-	sort.Slice(appScope[:], func(i, j int) bool {
-		return appScope[i].Service < appScope[j].Service
-	})
+	appScope = normalizeApplicationScope(appScope)
 
 	tflog.Info(ctx, fmt.Sprintf("expandApplicationScope Application Service Scope: %+v", appScope))
 
 	return appScope
 }
 
+// normalizeApplicationScope returns scopes in the form the Account API returns
+// them: one entry per service, sorted by service, with sorted unique roles.
+func normalizeApplicationScope(scopes []account.ApplicationServiceScope) []account.ApplicationServiceScope {
+	roles := make(map[string]map[string]struct{})
+
+	for _, scope := range scopes {
+		if _, ok := roles[scope.Service]; !ok {
+			roles[scope.Service] = make(map[string]struct{})
+		}
+
+		for _, role := range scope.Roles {
+			roles[scope.Service][role] = struct{}{}
+		}
+	}
+
+	normalized := make([]account.ApplicationServiceScope, 0, len(roles))
+
+	for service, serviceRoles := range roles {
+		scope := account.ApplicationServiceScope{
+			Service: service,
+			Roles:   make([]string, 0, len(serviceRoles)),
+		}
+
+		for role := range serviceRoles {
+			scope.Roles = append(scope.Roles, role)
+		}
+
+		sort.Strings(scope.Roles)
+		normalized = append(normalized, scope)
+	}
+
+	sort.Slice(normalized, func(i, j int) bool {
+		return normalized[i].Service < normalized[j].Service
+	})
+
+	return normalized
+}
+
+// overrideScopes combines preset scopes with explicit scopes. An explicit scope
+// replaces the preset's roles for the same service rather than adding to them.
+func overrideScopes(preset []account.ApplicationServiceScope, explicit []account.ApplicationServiceScope) []account.ApplicationServiceScope {
+	combined := make([]account.ApplicationServiceScope, 0, len(preset)+len(explicit))
+
+	for _, scope := range preset {
+		overridden := false
+		for _, e := range explicit {
+			if e.Service == scope.Service {
+				overridden = true
+				break
+			}
+		}
+
+		if !overridden {
+			combined = append(combined, scope)
+		}
+	}
+
+	return normalizeApplicationScope(append(combined, explicit...))
+}
+
+// policyDocument is the JSON form of a set of service scopes, as produced by
+// the account_application_policy_document data source.
+type policyDocument struct {
+	Scopes []account.ApplicationServiceScope `json:"scopes"`
+}
+
+// parsePolicyDocument decodes a policy document into normalized scopes.
+func parsePolicyDocument(document string) ([]account.ApplicationServiceScope, error) {
+	var policy policyDocument
+
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&policy); err != nil {
+		return nil, fmt.Errorf("invalid policy document: %w", err)
+	}
+
+	for i, scope := range policy.Scopes {
+		if len(scope.Service) == 0 {
+			return nil, fmt.Errorf("invalid policy document: scope %d has no service", i)
+		}
+	}
+
+	return normalizeApplicationScope(policy.Scopes), nil
+}
+
+// policyDocumentJSON encodes scopes as a canonical policy document, so that
+// equivalent documents compare equal as strings.
+func policyDocumentJSON(scopes []account.ApplicationServiceScope) (string, error) {
+	document, err := json.Marshal(policyDocument{Scopes: normalizeApplicationScope(scopes)})
+	if err != nil {
+		return "", err
+	}
+
+	return string(document), nil
+}
+
+// composePolicyDocument combines scopes in merge order. Source documents are
+// merged together, adding their roles for the same service. Explicit scopes
+// then replace the sources' roles for the same service, and each override
+// document in turn replaces the roles of the scopes before it.
+func composePolicyDocument(sources [][]account.ApplicationServiceScope, explicit []account.ApplicationServiceScope, overrides [][]account.ApplicationServiceScope) []account.ApplicationServiceScope {
+	var merged []account.ApplicationServiceScope
+	for _, source := range sources {
+		merged = append(merged, source...)
+	}
+
+	composed := overrideScopes(normalizeApplicationScope(merged), explicit)
+
+	for _, override := range overrides {
+		composed = overrideScopes(composed, override)
+	}
+
+	return composed
+}
+
+// currentApplicationScopes returns the scopes granted to the application
+// appID, or none when it does not exist.
+func currentApplicationScopes(service account.AccountService, appID string) ([]account.ApplicationServiceScope, error) {
+	services, err := service.GetApplicationServices(appID)
+	if isNotFoundError(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return services.Scopes, nil
+}
+
+// escalatedScopes returns the roles in planned which prior does not grant,
+// by service.
+func escalatedScopes(prior []account.ApplicationServiceScope, planned []account.ApplicationServiceScope) []account.ApplicationServiceScope {
+	granted := make(map[string]map[string]bool, len(prior))
+
+	for _, scope := range prior {
+		if granted[scope.Service] == nil {
+			granted[scope.Service] = make(map[string]bool, len(scope.Roles))
+		}
+
+		for _, role := range scope.Roles {
+			granted[scope.Service][role] = true
+		}
+	}
+
+	var escalated []account.ApplicationServiceScope
+
+	for _, scope := range normalizeApplicationScope(planned) {
+		var roles []string
+
+		for _, role := range scope.Roles {
+			if !granted[scope.Service][role] {
+				roles = append(roles, role)
+			}
+		}
+
+		if len(roles) > 0 {
+			escalated = append(escalated, account.ApplicationServiceScope{
+				Service: scope.Service,
+				Roles:   roles,
+			})
+		}
+	}
+
+	return escalated
+}
+
+// withoutService returns scopes without the entry for service.
+func withoutService(scopes []account.ApplicationServiceScope, service string) []account.ApplicationServiceScope {
+	remaining := make([]account.ApplicationServiceScope, 0, len(scopes))
+
+	for _, scope := range scopes {
+		if scope.Service != service {
+			remaining = append(remaining, scope)
+		}
+	}
+
+	return remaining
+}
+
+// normalizeRanges returns IP ranges in canonical form, sorted and without
+// duplicates. Addresses are kept as addresses and networks are masked, so
+// "10.0.0.1/8" becomes "10.0.0.0/8".
+func normalizeRanges(ranges []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(ranges))
+	normalized := make([]string, 0, len(ranges))
+
+	for _, r := range ranges {
+		prefix, err := parseRange(r)
+		if err != nil {
+			return nil, err
+		}
+
+		canonical := prefix.String()
+		if prefix.IsSingleIP() {
+			canonical = prefix.Addr().String()
+		}
+
+		if _, ok := seen[canonical]; ok {
+			continue
+		}
+
+		seen[canonical] = struct{}{}
+		normalized = append(normalized, canonical)
+	}
+
+	sort.Strings(normalized)
+
+	return normalized, nil
+}
+
+// parseRange parses an address or CIDR network from an IP restriction.
+func parseRange(r string) (netip.Prefix, error) {
+	r = strings.TrimSpace(r)
+
+	if strings.Contains(r, "/") {
+		prefix, err := netip.ParsePrefix(r)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid IP range %q: %w", r, err)
+		}
+
+		return prefix.Masked(), nil
+	}
+
+	addr, err := netip.ParseAddr(r)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid IP range %q: %w", r, err)
+	}
+
+	return netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()), nil
+}
+
+// ipAllowed reports whether ip may use a key with the given restriction. A
+// restriction without ranges does not restrict anything.
+func ipAllowed(ip string, restrictionType string, ranges []string) (bool, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return false, fmt.Errorf("invalid IP address %q: %w", ip, err)
+	}
+
+	addr = addr.Unmap()
+
+	if restrictionType != "allowlist" && restrictionType != "denylist" {
+		return false, fmt.Errorf("invalid restriction type %q, expected allowlist or denylist", restrictionType)
+	}
+
+	if len(ranges) == 0 {
+		return true, nil
+	}
+
+	matched := false
+
+	for _, r := range ranges {
+		prefix, err := parseRange(r)
+		if err != nil {
+			return false, err
+		}
+
+		if prefix.Contains(addr) {
+			matched = true
+			break
+		}
+	}
+
+	if restrictionType == "allowlist" {
+		return matched, nil
+	}
+
+	return !matched, nil
+}
+
+// rangesEquivalent reports whether two lists of IP ranges normalize to the same
+// ranges. Lists that cannot be parsed are compared as given.
+func rangesEquivalent(a, b []string) bool {
+	normalizedA, errA := normalizeRanges(a)
+	normalizedB, errB := normalizeRanges(b)
+
+	if errA != nil || errB != nil {
+		return reflect.DeepEqual(a, b)
+	}
+
+	return reflect.DeepEqual(normalizedA, normalizedB)
+}
+
+// nullTimeouts returns an unset timeouts block, for state written before
+// resources supported timeouts.
+func nullTimeouts() timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		}),
+	}
+}
+
 func expandArray(ctx context.Context, rawArray []types.String) []string {
 	expandedArray := make([]string, len(rawArray))
 
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ClientModel describes a reseller client account.
+type ClientModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	CompanyName      types.String `tfsdk:"company_name"`
+	FirstName        types.String `tfsdk:"first_name"`
+	LastName         types.String `tfsdk:"last_name"`
+	EmailAddress     types.String `tfsdk:"email_address"`
+	LimitedNumber    types.String `tfsdk:"limited_number"`
+	VATNumber        types.String `tfsdk:"vat_number"`
+	Address          types.String `tfsdk:"address"`
+	Address1         types.String `tfsdk:"address1"`
+	City             types.String `tfsdk:"city"`
+	County           types.String `tfsdk:"county"`
+	Country          types.String `tfsdk:"country"`
+	Postcode         types.String `tfsdk:"postcode"`
+	Phone            types.String `tfsdk:"phone"`
+	Fax              types.String `tfsdk:"fax"`
+	Mobile           types.String `tfsdk:"mobile"`
+	Type             types.String `tfsdk:"type"`
+	UserName         types.String `tfsdk:"user_name"`
+	IDReference      types.String `tfsdk:"id_reference"`
+	NominetContactID types.String `tfsdk:"nominet_contact_id"`
+	CreatedDate      types.String `tfsdk:"created_date"`
+}
+
+func readClient(client account.Client) ClientModel {
+	return ClientModel{
+		ID:               types.Int64Value(int64(client.ID)),
+		CompanyName:      types.StringValue(client.CompanyName),
+		FirstName:        types.StringValue(client.FirstName),
+		LastName:         types.StringValue(client.LastName),
+		EmailAddress:     types.StringValue(client.EmailAddress),
+		LimitedNumber:    types.StringValue(client.LimitedNumber),
+		VATNumber:        types.StringValue(client.VATNumber),
+		Address:          types.StringValue(client.Address),
+		Address1:         types.StringValue(client.Address1),
+		City:             types.StringValue(client.City),
+		County:           types.StringValue(client.County),
+		Country:          types.StringValue(client.Country),
+		Postcode:         types.StringValue(client.Postcode),
+		Phone:            types.StringValue(client.Phone),
+		Fax:              types.StringValue(client.Fax),
+		Mobile:           types.StringValue(client.Mobile),
+		Type:             types.StringValue(client.Type),
+		UserName:         types.StringValue(client.UserName),
+		IDReference:      types.StringValue(client.IDReference),
+		NominetContactID: types.StringValue(client.NominetContactID),
+		CreatedDate:      types.StringValue(client.CreatedDate),
+	}
+}
+
+// clientAttributeDescriptions describes each client attribute, shared by the
+// resource and data source schemas.
+var clientAttributeDescriptions = map[string]string{
+	"company_name":       "Company name of the client",
+	"first_name":         "First name of the client's contact",
+	"last_name":          "Last name of the client's contact",
+	"email_address":      "Email address of the client's contact",
+	"limited_number":     "Company registration number of the client",
+	"vat_number":         "VAT number of the client",
+	"address":            "First line of the client's address",
+	"address1":           "Second line of the client's address",
+	"city":               "City of the client's address",
+	"county":             "County of the client's address",
+	"country":            "Country of the client's address",
+	"postcode":           "Postcode of the client's address",
+	"phone":              "Phone number of the client",
+	"fax":                "Fax number of the client",
+	"mobile":             "Mobile number of the client",
+	"type":               "Type of client",
+	"user_name":          "Username of the client",
+	"id_reference":       "Reference for the client",
+	"nominet_contact_id": "Nominet contact ID of the client",
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ContactModel describes an account contact.
+type ContactModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Type      types.String `tfsdk:"type"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+}
+
+func readContact(contact account.Contact) ContactModel {
+	return ContactModel{
+		ID:        types.Int64Value(int64(contact.ID)),
+		Type:      types.StringValue(contact.Type.String()),
+		FirstName: types.StringValue(contact.FirstName),
+		LastName:  types.StringValue(contact.LastName),
+	}
+}
+
+func readContacts(contacts []account.Contact) []ContactModel {
+	contactModels := make([]ContactModel, len(contacts))
+
+	for i, contact := range contacts {
+		contactModels[i] = readContact(contact)
+	}
+
+	return contactModels
+}
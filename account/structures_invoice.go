@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"strconv"
+
+	"github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// InvoiceModel describes an account invoice.
+type InvoiceModel struct {
+	ID    types.Int64   `tfsdk:"id"`
+	Date  types.String  `tfsdk:"date"`
+	Paid  types.Bool    `tfsdk:"paid"`
+	Net   types.Float64 `tfsdk:"net"`
+	VAT   types.Float64 `tfsdk:"vat"`
+	Gross types.Float64 `tfsdk:"gross"`
+}
+
+func readInvoice(invoice account.Invoice) InvoiceModel {
+	return InvoiceModel{
+		ID:    types.Int64Value(int64(invoice.ID)),
+		Date:  types.StringValue(invoice.Date.String()),
+		Paid:  types.BoolValue(invoice.Paid),
+		Net:   readApiFloat(invoice.Net),
+		VAT:   readApiFloat(invoice.VAT),
+		Gross: readApiFloat(invoice.Gross),
+	}
+}
+
+func readInvoices(invoices []account.Invoice) []InvoiceModel {
+	invoiceModels := make([]InvoiceModel, len(invoices))
+
+	for i, invoice := range invoices {
+		invoiceModels[i] = readInvoice(invoice)
+	}
+
+	return invoiceModels
+}
+
+// readApiFloat converts an API amount without the noise of widening a float32,
+// so 12.1 is stored as 12.1 rather than 12.100000381469727.
+func readApiFloat(v float32) types.Float64 {
+	f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(v), 'f', -1, 32), 64)
+
+	return types.Float64Value(f)
+}
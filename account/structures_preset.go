@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ans-group/sdk-go/pkg/connection"
+	"github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// presetReadOnlyAll grants the read role on every service.
+	presetReadOnlyAll = "read_only_all"
+	// presetFullAccess grants the read and write roles on each of preset_services.
+	presetFullAccess = "full_access"
+)
+
+// builtinPresets lists the presets provided without provider configuration.
+var builtinPresets = []string{presetFullAccess, presetReadOnlyAll}
+
+// PresetModel describes a custom preset declared in the provider block.
+type PresetModel struct {
+	Name     types.String              `tfsdk:"name"`
+	Services []ApplicationServiceScope `tfsdk:"service"`
+}
+
+// expandPresets validates the custom presets declared in the provider block
+// and returns them by name.
+func expandPresets(ctx context.Context, rawPresets []PresetModel) (map[string][]account.ApplicationServiceScope, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	presets := make(map[string][]account.ApplicationServiceScope, len(rawPresets))
+
+	for i, preset := range rawPresets {
+		name := preset.Name.ValueString()
+		namePath := path.Root("preset").AtListIndex(i).AtName("name")
+
+		if isBuiltinPreset(name) {
+			diags.AddAttributeError(namePath, "Invalid Preset Name",
+				fmt.Sprintf("%q is a built-in preset and cannot be redefined.", name))
+			continue
+		}
+
+		if _, ok := presets[name]; ok {
+			diags.AddAttributeError(namePath, "Duplicate Preset Name",
+				fmt.Sprintf("Preset %q is declared more than once.", name))
+			continue
+		}
+
+		presets[name] = expandApplicationScope(ctx, preset.Services)
+	}
+
+	return presets, diags
+}
+
+func isBuiltinPreset(name string) bool {
+	for _, builtin := range builtinPresets {
+		if name == builtin {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolvePreset expands a preset into service scopes. Built-in presets are
+// resolved against the services available to the account, and custom presets
+// are looked up in presets.
+func resolvePreset(service account.AccountService, presets map[string][]account.ApplicationServiceScope, name string, presetServices []string) ([]account.ApplicationServiceScope, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if name != presetFullAccess && len(presetServices) > 0 {
+		diags.AddAttributeError(path.Root("preset_services"), "Unexpected Preset Services",
+			fmt.Sprintf("preset_services only applies to the %s preset.", presetFullAccess))
+		return nil, diags
+	}
+
+	switch name {
+	case presetReadOnlyAll:
+		available, err := service.GetServices(connection.APIRequestParameters{})
+		if err != nil {
+			return nil, apiErrorDiagnostics("Error Retrieving Account Services", err, nil)
+		}
+
+		scopes := make([]account.ApplicationServiceScope, len(available))
+		for i, s := range available {
+			scopes[i] = account.ApplicationServiceScope{
+				Service: s.Name,
+				Roles:   []string{"read"},
+			}
+		}
+
+		return scopes, nil
+	case presetFullAccess:
+		if len(presetServices) == 0 {
+			diags.AddAttributeError(path.Root("preset_services"), "Missing Preset Services",
+				"The full_access preset requires preset_services to list the services to grant full access to.")
+			return nil, diags
+		}
+
+		scopes := make([]account.ApplicationServiceScope, len(presetServices))
+		for i, s := range presetServices {
+			scopes[i] = account.ApplicationServiceScope{
+				Service: s,
+				Roles:   []string{"read", "write"},
+			}
+		}
+
+		return scopes, nil
+	}
+
+	scopes, ok := presets[name]
+	if !ok {
+		custom := make([]string, 0, len(presets))
+		for presetName := range presets {
+			custom = append(custom, presetName)
+		}
+		sort.Strings(custom)
+
+		diags.AddAttributeError(path.Root("preset"), "Unknown Preset",
+			fmt.Sprintf("Preset %q is not defined. Built-in presets are %v, and presets declared in the provider block are %v.",
+				name, builtinPresets, custom))
+		return nil, diags
+	}
+
+	return scopes, nil
+}
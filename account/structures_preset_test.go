@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	accountservice "github.com/ans-group/sdk-go/pkg/service/account"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolvePreset(t *testing.T) {
+	service := testAccountService(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/account/v1/services" {
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+
+		_, _ = w.Write([]byte(`{"data":[{"id":"1","name":"ecloud"},{"id":"2","name":"account"}],"meta":{"pagination":{"total_pages":1}}}`))
+	})
+
+	presets := map[string][]accountservice.ApplicationServiceScope{
+		"billing": {{Service: "account", Roles: []string{"read", "write"}}},
+	}
+
+	cases := map[string]struct {
+		preset         string
+		presetServices []string
+		expected       []accountservice.ApplicationServiceScope
+		err            string
+	}{
+		"read only all": {
+			preset: presetReadOnlyAll,
+			expected: []accountservice.ApplicationServiceScope{
+				{Service: "ecloud", Roles: []string{"read"}},
+				{Service: "account", Roles: []string{"read"}},
+			},
+		},
+		"full access": {
+			preset:         presetFullAccess,
+			presetServices: []string{"ecloud"},
+			expected: []accountservice.ApplicationServiceScope{
+				{Service: "ecloud", Roles: []string{"read", "write"}},
+			},
+		},
+		"full access without services": {
+			preset: presetFullAccess,
+			err:    "Missing Preset Services",
+		},
+		"custom": {
+			preset:   "billing",
+			expected: presets["billing"],
+		},
+		"custom with services": {
+			preset:         "billing",
+			presetServices: []string{"ecloud"},
+			err:            "Unexpected Preset Services",
+		},
+		"unknown": {
+			preset: "missing",
+			err:    "Unknown Preset",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			scopes, diags := resolvePreset(service, presets, c.preset, c.presetServices)
+
+			if len(c.err) > 0 {
+				if !diags.HasError() || diags[0].Summary() != c.err {
+					t.Fatalf("expected %q error, got %v", c.err, diags)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !reflect.DeepEqual(scopes, c.expected) {
+				t.Errorf("expected %+v, got %+v", c.expected, scopes)
+			}
+		})
+	}
+}
+
+func TestOverrideScopes(t *testing.T) {
+	preset := []accountservice.ApplicationServiceScope{
+		{Service: "ecloud", Roles: []string{"read"}},
+		{Service: "account", Roles: []string{"read"}},
+	}
+	explicit := []accountservice.ApplicationServiceScope{
+		{Service: "ecloud", Roles: []string{"write", "read"}},
+		{Service: "ddosx", Roles: []string{"read"}},
+	}
+
+	expected := []accountservice.ApplicationServiceScope{
+		{Service: "account", Roles: []string{"read"}},
+		{Service: "ddosx", Roles: []string{"read"}},
+		{Service: "ecloud", Roles: []string{"read", "write"}},
+	}
+
+	if scopes := overrideScopes(preset, explicit); !reflect.DeepEqual(scopes, expected) {
+		t.Errorf("expected %+v, got %+v", expected, scopes)
+	}
+}
+
+func TestExpandPresets(t *testing.T) {
+	presets, diags := expandPresets(context.Background(), []PresetModel{
+		{Name: types.StringValue("billing"), Services: []ApplicationServiceScope{{Name: types.StringValue("account"), Roles: []types.String{types.StringValue("read")}}}},
+		{Name: types.StringValue("billing")},
+		{Name: types.StringValue(presetReadOnlyAll)},
+	})
+
+	if diags.ErrorsCount() != 2 {
+		t.Errorf("expected duplicate and built-in name errors, got %v", diags)
+	}
+
+	if len(presets["billing"]) != 1 || presets["billing"][0].Service != "account" {
+		t.Errorf("unexpected presets %+v", presets)
+	}
+}